@@ -0,0 +1,115 @@
+package btree_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/btree"
+	"github.com/playgroundgo/genlib/container/redblack"
+	"github.com/playgroundgo/genlib/generic"
+)
+
+var benchmarkSizes = []int{1000, 100000, 1000000}
+
+func randomKeys(n int) []int {
+	return rand.New(rand.NewSource(1)).Perm(n)
+}
+
+func BenchmarkPut(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		keys := randomKeys(size)
+		b.Run(fmt.Sprintf("btree/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				tree := btree.New[int, int](generic.Less[int])
+				for _, key := range keys {
+					tree.Put(key, key)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("redblack/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				tree := redblack.New[int, int](generic.Less[int])
+				for _, key := range keys {
+					tree.Upsert(key, key)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		keys := randomKeys(size)
+		bt := btree.New[int, int](generic.Less[int])
+		rb := redblack.New[int, int](generic.Less[int])
+		for _, key := range keys {
+			bt.Put(key, key)
+			rb.Upsert(key, key)
+		}
+		b.Run(fmt.Sprintf("btree/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				bt.Get(keys[i%size])
+			}
+		})
+		b.Run(fmt.Sprintf("redblack/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				rb.Find(keys[i%size])
+			}
+		})
+	}
+}
+
+func BenchmarkDelete(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		keys := randomKeys(size)
+		b.Run(fmt.Sprintf("btree/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				tree := btree.New[int, int](generic.Less[int])
+				for _, key := range keys {
+					tree.Put(key, key)
+				}
+				b.StartTimer()
+				for _, key := range keys {
+					tree.Delete(key)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("redblack/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				tree := redblack.New[int, int](generic.Less[int])
+				for _, key := range keys {
+					tree.Upsert(key, key)
+				}
+				b.StartTimer()
+				for _, key := range keys {
+					tree.Delete(key)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkAscend(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		keys := randomKeys(size)
+		bt := btree.New[int, int](generic.Less[int])
+		rb := redblack.New[int, int](generic.Less[int])
+		for _, key := range keys {
+			bt.Put(key, key)
+			rb.Upsert(key, key)
+		}
+		b.Run(fmt.Sprintf("btree/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				bt.Ascend(func(_, _ int) bool { return true })
+			}
+		})
+		b.Run(fmt.Sprintf("redblack/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				rb.Ascend(func(_, _ int) bool { return true })
+			}
+		})
+	}
+}
@@ -0,0 +1,411 @@
+package btree
+
+import (
+	"fmt"
+
+	"github.com/playgroundgo/genlib/generic"
+)
+
+// DefaultDegree is the default minimum number of children of the internal nodes.
+const DefaultDegree = 32
+
+type item[K, V any] struct {
+	key   K
+	value V
+}
+
+// owner identifies the tree allowed to modify a node in place. The nodes shared between clones
+// have a stale owner, so they are copied before being modified.
+type owner struct {
+	// The field makes sure that each owner has a distinct address.
+	_ byte
+}
+
+type node[K, V any] struct {
+	items    []item[K, V]
+	children []*node[K, V]
+	owner    *owner
+}
+
+func (n *node[K, V]) isLeaf() bool {
+	return len(n.children) == 0
+}
+
+// BTree implements an ordered map using a B-tree, which stores many keys in each node to reduce
+// the memory overhead and improve the cache locality. Every node except the root holds between
+// degree-1 and 2*degree-1 keys.
+type BTree[K, V any] struct {
+	root   *node[K, V]
+	less   generic.LessFn[K]
+	degree int
+	size   uint32
+	owner  *owner
+}
+
+// New creates a new B-tree having the default degree, which sorts the keys using the provided
+// comparator function.
+func New[K, V any](less generic.LessFn[K]) *BTree[K, V] {
+	return NewWithDegree[K, V](less, DefaultDegree)
+}
+
+// NewWithDegree creates a new B-tree having the given degree. It panics if the degree is less
+// than 2.
+func NewWithDegree[K, V any](less generic.LessFn[K], degree int) *BTree[K, V] {
+	if degree < 2 {
+		panic(fmt.Sprintf("btree: invalid degree %d", degree))
+	}
+	return &BTree[K, V]{
+		less:   less,
+		degree: degree,
+		owner:  &owner{},
+	}
+}
+
+// IsEmpty returns 'true' if the tree has no elements.
+func (t *BTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Size returns the number of elements in the tree.
+func (t *BTree[K, V]) Size() uint32 {
+	return t.size
+}
+
+// Clone returns a copy of the tree in constant time. The two trees share their nodes until either
+// of them is modified, when the affected nodes are copied, so the modifications of one tree are
+// never visible in the other one.
+func (t *BTree[K, V]) Clone() *BTree[K, V] {
+	// Both trees lose the ownership of the existing nodes.
+	t.owner = &owner{}
+	clone := *t
+	clone.owner = &owner{}
+	return &clone
+}
+
+// Get returns the value associated with the given key.
+func (t *BTree[K, V]) Get(key K) (V, bool) {
+	for n := t.root; n != nil; {
+		i, found := t.search(n, key)
+		if found {
+			return n.items[i].value, true
+		}
+		if n.isLeaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	var tmp V
+	return tmp, false
+}
+
+// Put associates the given value with the key, replacing the previous value if the key is
+// already present. It returns 'true' if the key was inserted.
+func (t *BTree[K, V]) Put(key K, value V) bool {
+	if t.root == nil {
+		t.root = t.newNode()
+	}
+	t.root = t.mutable(t.root)
+	if len(t.root.items) == t.maxItems() {
+		median, right := t.split(t.root)
+		root := t.newNode()
+		root.items = append(root.items, median)
+		root.children = append(root.children, t.root, right)
+		t.root = root
+	}
+	if !t.insert(t.root, item[K, V]{key: key, value: value}) {
+		return false
+	}
+	t.size++
+	return true
+}
+
+// Delete removes the given key from the tree and returns the value associated with it.
+func (t *BTree[K, V]) Delete(key K) (V, bool) {
+	if t.root == nil {
+		var tmp V
+		return tmp, false
+	}
+	t.root = t.mutable(t.root)
+	removed, found := t.remove(t.root, key, false)
+	if len(t.root.items) == 0 {
+		if t.root.isLeaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+	if found {
+		t.size--
+	}
+	return removed.value, found
+}
+
+// Min returns the smallest key in the tree and its associated value.
+func (t *BTree[K, V]) Min() (K, V, bool) {
+	return t.extreme(false)
+}
+
+// Max returns the largest key in the tree and its associated value.
+func (t *BTree[K, V]) Max() (K, V, bool) {
+	return t.extreme(true)
+}
+
+// Ascend calls the function 'f' for each key-value pair in ascending key order while the function
+// returns true.
+func (t *BTree[K, V]) Ascend(f func(key K, value V) bool) {
+	ascend(t.root, f)
+}
+
+// Descend calls the function 'f' for each key-value pair in descending key order while the
+// function returns true.
+func (t *BTree[K, V]) Descend(f func(key K, value V) bool) {
+	descend(t.root, f)
+}
+
+func (t *BTree[K, V]) maxItems() int {
+	return 2*t.degree - 1
+}
+
+func (t *BTree[K, V]) minItems() int {
+	return t.degree - 1
+}
+
+func (t *BTree[K, V]) newNode() *node[K, V] {
+	return &node[K, V]{
+		items: make([]item[K, V], 0, t.maxItems()),
+		owner: t.owner,
+	}
+}
+
+// mutable returns a version of the node which can be modified by the tree, copying it if it's
+// shared with other trees.
+func (t *BTree[K, V]) mutable(n *node[K, V]) *node[K, V] {
+	if n.owner == t.owner {
+		return n
+	}
+	clone := t.newNode()
+	clone.items = append(clone.items, n.items...)
+	if !n.isLeaf() {
+		clone.children = make([]*node[K, V], len(n.children), 2*t.degree)
+		copy(clone.children, n.children)
+	}
+	return clone
+}
+
+// mutableChild makes the i-th child of the node mutable and returns it.
+func (t *BTree[K, V]) mutableChild(n *node[K, V], i int) *node[K, V] {
+	n.children[i] = t.mutable(n.children[i])
+	return n.children[i]
+}
+
+// search returns the index of the first item of the node having the key greater than or equal to
+// the given key, and whether the key was found.
+func (t *BTree[K, V]) search(n *node[K, V], key K) (int, bool) {
+	lo, hi := 0, len(n.items)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if t.less(n.items[mid].key, key) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(n.items) && !t.less(key, n.items[lo].key)
+}
+
+// split moves the second half of the items and children of a full node into a new node, and
+// returns the median item, which is removed from the node, and the new node.
+func (t *BTree[K, V]) split(n *node[K, V]) (item[K, V], *node[K, V]) {
+	mid := t.degree - 1
+	median := n.items[mid]
+	right := t.newNode()
+	right.items = append(right.items, n.items[mid+1:]...)
+	n.items = truncate(n.items, mid)
+	if !n.isLeaf() {
+		right.children = make([]*node[K, V], 0, 2*t.degree)
+		right.children = append(right.children, n.children[mid+1:]...)
+		n.children = truncate(n.children, mid+1)
+	}
+	return median, right
+}
+
+// insert inserts the item in the subtree rooted at the mutable node 'n', which isn't full, and
+// returns 'true' if the key wasn't already present.
+func (t *BTree[K, V]) insert(n *node[K, V], it item[K, V]) bool {
+	for {
+		i, found := t.search(n, it.key)
+		if found {
+			n.items[i].value = it.value
+			return false
+		}
+		if n.isLeaf() {
+			n.items = insertAt(n.items, i, it)
+			return true
+		}
+
+		child := t.mutableChild(n, i)
+		if len(child.items) == t.maxItems() {
+			median, right := t.split(child)
+			n.items = insertAt(n.items, i, median)
+			n.children = insertAt(n.children, i+1, right)
+			// The key may now belong to the new node or be the median itself.
+			continue
+		}
+		n = child
+	}
+}
+
+// remove removes the given key, or the largest key if 'max' is set, from the subtree rooted at
+// the mutable node 'n', which has more than the minimum number of items unless it's the root.
+func (t *BTree[K, V]) remove(n *node[K, V], key K, max bool) (item[K, V], bool) {
+	for {
+		var i int
+		var found bool
+		if max {
+			i = len(n.items)
+			if n.isLeaf() {
+				i--
+				found = true
+			}
+		} else {
+			i, found = t.search(n, key)
+		}
+
+		if n.isLeaf() {
+			if !found {
+				return item[K, V]{}, false
+			}
+			removed := n.items[i]
+			n.items = removeAt(n.items, i)
+			return removed, true
+		}
+
+		if len(n.children[i].items) <= t.minItems() {
+			// Make sure the child can lose an item, then search the node again.
+			t.grow(n, i)
+			continue
+		}
+		child := t.mutableChild(n, i)
+		if found {
+			// Replace the item with its predecessor, which is the largest key of the left child.
+			removed := n.items[i]
+			n.items[i], _ = t.remove(child, key, true)
+			return removed, true
+		}
+		n = child
+	}
+}
+
+// grow adds an item to the i-th child of the mutable node 'n', either by moving an item from a
+// sibling through the node or by merging the child with a sibling.
+func (t *BTree[K, V]) grow(n *node[K, V], i int) {
+	if i > 0 && len(n.children[i-1].items) > t.minItems() {
+		child, left := t.mutableChild(n, i), t.mutableChild(n, i-1)
+		child.items = insertAt(child.items, 0, n.items[i-1])
+		n.items[i-1] = left.items[len(left.items)-1]
+		left.items = removeAt(left.items, len(left.items)-1)
+		if !left.isLeaf() {
+			child.children = insertAt(child.children, 0, left.children[len(left.children)-1])
+			left.children = removeAt(left.children, len(left.children)-1)
+		}
+		return
+	}
+	if i < len(n.items) && len(n.children[i+1].items) > t.minItems() {
+		child, right := t.mutableChild(n, i), t.mutableChild(n, i+1)
+		child.items = append(child.items, n.items[i])
+		n.items[i] = right.items[0]
+		right.items = removeAt(right.items, 0)
+		if !right.isLeaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = removeAt(right.children, 0)
+		}
+		return
+	}
+
+	if i == len(n.items) {
+		i--
+	}
+	child, right := t.mutableChild(n, i), n.children[i+1]
+	child.items = append(child.items, n.items[i])
+	child.items = append(child.items, right.items...)
+	child.children = append(child.children, right.children...)
+	n.items = removeAt(n.items, i)
+	n.children = removeAt(n.children, i+1)
+}
+
+// extreme returns the smallest item of the tree, or the largest one if 'last' is set.
+func (t *BTree[K, V]) extreme(last bool) (K, V, bool) {
+	n := t.root
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	for !n.isLeaf() {
+		if last {
+			n = n.children[len(n.children)-1]
+		} else {
+			n = n.children[0]
+		}
+	}
+	it := n.items[0]
+	if last {
+		it = n.items[len(n.items)-1]
+	}
+	return it.key, it.value, true
+}
+
+func ascend[K, V any](n *node[K, V], f func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	for i, it := range n.items {
+		if !n.isLeaf() && !ascend(n.children[i], f) {
+			return false
+		}
+		if !f(it.key, it.value) {
+			return false
+		}
+	}
+	return n.isLeaf() || ascend(n.children[len(n.children)-1], f)
+}
+
+func descend[K, V any](n *node[K, V], f func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	for i := len(n.items) - 1; i >= 0; i-- {
+		if !n.isLeaf() && !descend(n.children[i+1], f) {
+			return false
+		}
+		if !f(n.items[i].key, n.items[i].value) {
+			return false
+		}
+	}
+	return n.isLeaf() || descend(n.children[0], f)
+}
+
+func insertAt[T any](s []T, i int, elem T) []T {
+	var zero T
+	s = append(s, zero)
+	copy(s[i+1:], s[i:])
+	s[i] = elem
+	return s
+}
+
+func removeAt[T any](s []T, i int) []T {
+	copy(s[i:], s[i+1:])
+	var zero T
+	s[len(s)-1] = zero
+	return s[:len(s)-1]
+}
+
+// truncate shortens the slice to 'n' elements, clearing the removed ones so that they can be
+// garbage collected.
+func truncate[T any](s []T, n int) []T {
+	var zero T
+	for i := n; i < len(s); i++ {
+		s[i] = zero
+	}
+	return s[:n]
+}
@@ -0,0 +1,207 @@
+package btree_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/btree"
+	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/slices"
+)
+
+func keys(t *btree.BTree[int, int]) []int {
+	keys := make([]int, 0, t.Size())
+	t.Ascend(func(key, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func checkTree(t *testing.T, tree *btree.BTree[int, int], expected []int) {
+	t.Helper()
+	if err := tree.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(keys(tree), expected) || int(tree.Size()) != len(expected) {
+		t.Fatalf("expected keys %v, got %v", expected, keys(tree))
+	}
+}
+
+func TestBTree(t *testing.T) {
+	tree := btree.NewWithDegree[int, int](generic.Less[int], 2)
+	if _, _, ok := tree.Min(); ok || !tree.IsEmpty() {
+		t.Fatal("expected the tree to be empty")
+	}
+	for _, key := range []int{5, 3, 8, 1, 4, 7, 9, 2, 6} {
+		if !tree.Put(key, key*10) {
+			t.Fatalf("expected to insert key %d", key)
+		}
+	}
+	checkTree(t, tree, []int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	if tree.Put(4, 400) {
+		t.Fatal("didn't expected to insert an existing key")
+	}
+	if value, ok := tree.Get(4); !ok || value != 400 {
+		t.Fatalf("expected to get 400 for key 4, got %d", value)
+	}
+	if _, ok := tree.Get(10); ok {
+		t.Fatal("didn't expected to get a missing key")
+	}
+	if key, value, ok := tree.Min(); !ok || key != 1 || value != 10 {
+		t.Fatalf("expected min 1, got %d", key)
+	}
+	if key, value, ok := tree.Max(); !ok || key != 9 || value != 90 {
+		t.Fatalf("expected max 9, got %d", key)
+	}
+
+	descending := make([]int, 0)
+	tree.Descend(func(key, _ int) bool {
+		descending = append(descending, key)
+		return key > 6
+	})
+	if expected := []int{9, 8, 7, 6}; !slices.Equal(descending, expected) {
+		t.Fatalf("expected %v, got %v", expected, descending)
+	}
+
+	if value, ok := tree.Delete(5); !ok || value != 50 {
+		t.Fatalf("expected to delete 50 for key 5, got %d", value)
+	}
+	if _, ok := tree.Delete(5); ok {
+		t.Fatal("didn't expected to delete a missing key")
+	}
+	checkTree(t, tree, []int{1, 2, 3, 4, 6, 7, 8, 9})
+	for _, key := range []int{1, 2, 3, 4, 6, 7, 8, 9} {
+		tree.Delete(key)
+	}
+	checkTree(t, tree, []int{})
+}
+
+func TestBTreeRandomized(t *testing.T) {
+	for _, degree := range []int{2, 3, 8, 32} {
+		rnd := rand.New(rand.NewSource(int64(degree)))
+		tree := btree.NewWithDegree[int, int](generic.Less[int], degree)
+		model := make(map[int]int)
+		for i := 0; i < 20000; i++ {
+			key := rnd.Intn(2000)
+			if rnd.Intn(2) == 0 {
+				_, found := model[key]
+				if tree.Put(key, i) == found {
+					t.Fatalf("expected insertion of key %d to be %v", key, !found)
+				}
+				model[key] = i
+			} else {
+				expected, found := model[key]
+				if value, ok := tree.Delete(key); ok != found || value != expected {
+					t.Fatalf("expected to delete %d for key %d, got %d", expected, key, value)
+				}
+				delete(model, key)
+			}
+			if i%1000 == 0 {
+				if err := tree.Validate(); err != nil {
+					t.Fatalf("degree %d: %v", degree, err)
+				}
+			}
+		}
+
+		expected := make([]int, 0, len(model))
+		for key := range model {
+			expected = append(expected, key)
+		}
+		slices.Sort(expected)
+		checkTree(t, tree, expected)
+		for key, value := range model {
+			if got, ok := tree.Get(key); !ok || got != value {
+				t.Fatalf("expected to get %d for key %d, got %d", value, key, got)
+			}
+		}
+	}
+}
+
+func TestBTreeClone(t *testing.T) {
+	tree := btree.NewWithDegree[int, int](generic.Less[int], 2)
+	for key := 0; key < 100; key++ {
+		tree.Put(key, key)
+	}
+	clone := tree.Clone()
+	for key := 0; key < 100; key += 2 {
+		tree.Delete(key)
+		clone.Put(key, -key)
+	}
+	for key := 100; key < 150; key++ {
+		clone.Put(key, key)
+	}
+	snapshot := clone.Clone()
+	snapshot.Put(1, 1000)
+
+	odd, all := make([]int, 0), make([]int, 0)
+	for key := 0; key < 150; key++ {
+		if key < 100 && key%2 == 1 {
+			odd = append(odd, key)
+		}
+		all = append(all, key)
+	}
+	checkTree(t, tree, odd)
+	checkTree(t, clone, all)
+	checkTree(t, snapshot, all)
+	if value, _ := tree.Get(1); value != 1 {
+		t.Fatalf("expected the original value of key 1, got %d", value)
+	}
+	if value, _ := clone.Get(2); value != -2 {
+		t.Fatalf("expected the replaced value of key 2, got %d", value)
+	}
+	if value, _ := clone.Get(1); value != 1 {
+		t.Fatalf("didn't expected the snapshot change in the clone, got %d", value)
+	}
+	if value, _ := snapshot.Get(1); value != 1000 {
+		t.Fatalf("expected the snapshot change, got %d", value)
+	}
+}
+
+func TestInvalidDegree(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for degree 1")
+		}
+	}()
+	btree.NewWithDegree[int, int](generic.Less[int], 1)
+}
+
+func TestBTreeCloneRandomized(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	trees := []*btree.BTree[int, int]{btree.NewWithDegree[int, int](generic.Less[int], 3)}
+	models := []map[int]int{{}}
+	for i := 0; i < 20000; i++ {
+		j := rnd.Intn(len(trees))
+		if i%500 == 0 && len(trees) < 8 {
+			trees = append(trees, trees[j].Clone())
+			models = append(models, make(map[int]int))
+			for key, value := range models[j] {
+				models[len(models)-1][key] = value
+			}
+			continue
+		}
+		key := rnd.Intn(500)
+		if rnd.Intn(2) == 0 {
+			trees[j].Put(key, i)
+			models[j][key] = i
+		} else {
+			trees[j].Delete(key)
+			delete(models[j], key)
+		}
+	}
+
+	for j, tree := range trees {
+		expected := make([]int, 0, len(models[j]))
+		for key := range models[j] {
+			expected = append(expected, key)
+		}
+		slices.Sort(expected)
+		checkTree(t, tree, expected)
+		for key, value := range models[j] {
+			if got, _ := tree.Get(key); got != value {
+				t.Fatalf("expected to get %d for key %d in tree %d, got %d", value, key, j, got)
+			}
+		}
+	}
+}
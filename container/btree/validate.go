@@ -0,0 +1,77 @@
+package btree
+
+import "fmt"
+
+// Validate verifies the structural invariants of the tree: the ordering of the keys, the number of
+// items and children of each node, the equal depth of all the leaves and the size of the tree. It
+// returns an error describing the first violation found.
+func (t *BTree[K, V]) Validate() error {
+	if t.root == nil {
+		if t.size != 0 {
+			return fmt.Errorf("empty tree reports size %d", t.size)
+		}
+		return nil
+	}
+	if len(t.root.items) == 0 {
+		return fmt.Errorf("root node has no items")
+	}
+	count, _, err := t.validateNode(t.root, nil, nil, true)
+	if err != nil {
+		return err
+	}
+	if count != int(t.size) {
+		return fmt.Errorf("tree reports size %d, but contains %d items", t.size, count)
+	}
+	return nil
+}
+
+// validateNode validates the subtree rooted at 'n' whose keys must be in the range (lo, hi) and
+// returns the number of items and the height of the subtree.
+func (t *BTree[K, V]) validateNode(n *node[K, V], lo, hi *item[K, V], root bool) (int, int,
+	error) {
+	if len(n.items) > t.maxItems() || (!root && len(n.items) < t.minItems()) {
+		return 0, 0, fmt.Errorf("node has %d items, expected between %d and %d", len(n.items),
+			t.minItems(), t.maxItems())
+	}
+	for i := range n.items {
+		prev := lo
+		if i > 0 {
+			prev = &n.items[i-1]
+		}
+		if prev != nil && !t.less(prev.key, n.items[i].key) {
+			return 0, 0, fmt.Errorf("key %v is not greater than its predecessor %v",
+				n.items[i].key, prev.key)
+		}
+	}
+	if last := n.items[len(n.items)-1]; hi != nil && !t.less(last.key, hi.key) {
+		return 0, 0, fmt.Errorf("key %v is not less than its successor %v", last.key, hi.key)
+	}
+	if n.isLeaf() {
+		return len(n.items), 1, nil
+	}
+
+	if len(n.children) != len(n.items)+1 {
+		return 0, 0, fmt.Errorf("node starting with key %v has %d items and %d children",
+			n.items[0].key, len(n.items), len(n.children))
+	}
+	count, height := len(n.items), 0
+	for i, child := range n.children {
+		childLo, childHi := lo, hi
+		if i > 0 {
+			childLo = &n.items[i-1]
+		}
+		if i < len(n.items) {
+			childHi = &n.items[i]
+		}
+		childCount, childHeight, err := t.validateNode(child, childLo, childHi, false)
+		if err != nil {
+			return 0, 0, err
+		}
+		if i > 0 && childHeight != height {
+			return 0, 0, fmt.Errorf("leaves of node starting with key %v have different depths",
+				n.items[0].key)
+		}
+		count, height = count+childCount, childHeight
+	}
+	return count, height + 1, nil
+}
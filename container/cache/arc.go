@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/playgroundgo/genlib/container/lru"
+	"github.com/playgroundgo/genlib/generic"
+)
+
+// ARC implements the adaptive replacement cache policy. The entries are split between a list of
+// the entries accessed once recently and a list of the entries accessed at least twice, and the
+// target size of each list adapts to the workload using the history of the recently evicted keys.
+// A single pass over many keys can only evict the entries accessed once, so the cache is resistant
+// to scans. An ARC is not safe for concurrent use.
+type ARC[K comparable, V any] struct {
+	capacity int
+	// target is the target size of the recent list.
+	target   int
+	recent   *lru.Cache[K, V]
+	frequent *lru.Cache[K, V]
+	// recentGhosts and frequentGhosts hold the keys recently evicted from each list.
+	recentGhosts   *lru.Cache[K, struct{}]
+	frequentGhosts *lru.Cache[K, struct{}]
+}
+
+// NewARC returns an empty adaptive replacement cache which can hold up to 'capacity' entries. It
+// panics if the capacity is not positive.
+func NewARC[K comparable, V any](capacity int) *ARC[K, V] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("cache: invalid capacity %d", capacity))
+	}
+	return &ARC[K, V]{
+		capacity:       capacity,
+		recent:         lru.New[K, V](capacity),
+		frequent:       lru.New[K, V](capacity),
+		recentGhosts:   lru.New[K, struct{}](capacity),
+		frequentGhosts: lru.New[K, struct{}](capacity),
+	}
+}
+
+// Get returns the value associated with the given key and records the access.
+func (c *ARC[K, V]) Get(key K) (V, bool) {
+	if value, found := c.recent.Remove(key); found {
+		c.frequent.Put(key, value)
+		return value, true
+	}
+	return c.frequent.Get(key)
+}
+
+// Peek returns the value associated with the given key without recording the access.
+func (c *ARC[K, V]) Peek(key K) (V, bool) {
+	if value, found := c.recent.Peek(key); found {
+		return value, true
+	}
+	return c.frequent.Peek(key)
+}
+
+// Contains verifies if the given key is found in the cache without recording the access.
+func (c *ARC[K, V]) Contains(key K) bool {
+	return c.recent.Contains(key) || c.frequent.Contains(key)
+}
+
+// Put adds a key-value pair to the cache, or replaces the value if the key is already present.
+func (c *ARC[K, V]) Put(key K, value V) {
+	if _, found := c.recent.Remove(key); found {
+		c.frequent.Put(key, value)
+		return
+	}
+	if c.frequent.Contains(key) {
+		c.frequent.Put(key, value)
+		return
+	}
+
+	switch {
+	case c.recentGhosts.Contains(key):
+		// The recent list was too small, so grow its target size.
+		delta := 1
+		if c.recentGhosts.Len() < c.frequentGhosts.Len() {
+			delta = c.frequentGhosts.Len() / c.recentGhosts.Len()
+		}
+		c.target = generic.Min(c.target+delta, c.capacity)
+		c.makeSpace(false)
+		c.recentGhosts.Remove(key)
+		c.frequent.Put(key, value)
+	case c.frequentGhosts.Contains(key):
+		// The frequent list was too small, so shrink the target size of the recent list.
+		delta := 1
+		if c.frequentGhosts.Len() < c.recentGhosts.Len() {
+			delta = c.recentGhosts.Len() / c.frequentGhosts.Len()
+		}
+		c.target = generic.Max(c.target-delta, 0)
+		c.makeSpace(true)
+		c.frequentGhosts.Remove(key)
+		c.frequent.Put(key, value)
+	default:
+		c.makeSpace(false)
+		if c.recentGhosts.Len() > c.capacity-c.target {
+			c.recentGhosts.RemoveOldest()
+		}
+		if c.frequentGhosts.Len() > c.target {
+			c.frequentGhosts.RemoveOldest()
+		}
+		c.recent.Put(key, value)
+	}
+}
+
+// Remove removes the given key from the cache and returns the value associated with it.
+func (c *ARC[K, V]) Remove(key K) (V, bool) {
+	c.recentGhosts.Remove(key)
+	c.frequentGhosts.Remove(key)
+	if value, found := c.recent.Remove(key); found {
+		return value, true
+	}
+	return c.frequent.Remove(key)
+}
+
+// Len returns the number of entries in the cache.
+func (c *ARC[K, V]) Len() int {
+	return c.recent.Len() + c.frequent.Len()
+}
+
+// makeSpace evicts an entry if the cache is full, choosing the list depending on its target size.
+func (c *ARC[K, V]) makeSpace(frequentGhost bool) {
+	if c.Len() < c.capacity {
+		return
+	}
+	recentLen := c.recent.Len()
+	if recentLen > 0 && (recentLen > c.target || (recentLen == c.target && frequentGhost)) {
+		key, _, _ := c.recent.RemoveOldest()
+		c.recentGhosts.Put(key, struct{}{})
+		return
+	}
+	if key, _, ok := c.frequent.RemoveOldest(); ok {
+		c.frequentGhosts.Put(key, struct{}{})
+	}
+}
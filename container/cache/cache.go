@@ -0,0 +1,28 @@
+package cache
+
+import "github.com/playgroundgo/genlib/container/lru"
+
+// Cache is the common interface of the caches holding a bounded number of entries, which differ
+// only in the policy used to choose the entries to evict.
+type Cache[K comparable, V any] interface {
+	// Get returns the value associated with the given key and records the access.
+	Get(key K) (V, bool)
+	// Peek returns the value associated with the given key without recording the access.
+	Peek(key K) (V, bool)
+	// Contains verifies if the given key is found in the cache without recording the access.
+	Contains(key K) bool
+	// Put adds a key-value pair to the cache, or replaces the value if the key is already present.
+	Put(key K, value V)
+	// Remove removes the given key from the cache and returns the value associated with it.
+	Remove(key K) (V, bool)
+	// Len returns the number of entries in the cache.
+	Len() int
+}
+
+var (
+	_ Cache[int, int] = (*lru.Cache[int, int])(nil)
+	_ Cache[int, int] = (*lru.SyncCache[int, int])(nil)
+	_ Cache[int, int] = (*ARC[int, int])(nil)
+	_ Cache[int, int] = (*TwoQueue[int, int])(nil)
+	_ Cache[int, int] = (*TinyLFU[int, int])(nil)
+)
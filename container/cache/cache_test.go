@@ -0,0 +1,110 @@
+package cache_test
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestCacheOperations(t *testing.T) {
+	for _, p := range policies() {
+		t.Run(p.name, func(t *testing.T) {
+			c := p.newCache(10)
+			if _, found := c.Get(1); found || c.Len() != 0 {
+				t.Fatal("expected the cache to be empty")
+			}
+			c.Put(1, 10)
+			c.Put(2, 20)
+			if value, found := c.Get(1); !found || value != 10 {
+				t.Fatalf("expected to get 10 for key 1, got %d", value)
+			}
+			if value, found := c.Peek(2); !found || value != 20 {
+				t.Fatalf("expected to peek 20 for key 2, got %d", value)
+			}
+			c.Put(1, 100)
+			if value, _ := c.Get(1); value != 100 || c.Len() != 2 {
+				t.Fatalf("expected to replace the value of key 1, got %d", value)
+			}
+			if value, found := c.Remove(2); !found || value != 20 {
+				t.Fatalf("expected to remove 20 for key 2, got %d", value)
+			}
+			if _, found := c.Remove(2); found || c.Contains(2) || c.Len() != 1 {
+				t.Fatal("didn't expected to find a removed key")
+			}
+		})
+	}
+}
+
+func TestCacheRandomized(t *testing.T) {
+	const capacity = 64
+	for _, p := range policies() {
+		t.Run(p.name, func(t *testing.T) {
+			rnd := rand.New(rand.NewSource(1))
+			c := p.newCache(capacity)
+			values := make(map[uint64]uint64)
+			for i := 0; i < 100000; i++ {
+				key := uint64(rnd.Intn(4 * capacity))
+				switch rnd.Intn(4) {
+				case 0:
+					value := rnd.Uint64()
+					c.Put(key, value)
+					values[key] = value
+				case 1:
+					c.Remove(key)
+					delete(values, key)
+				default:
+					if value, found := c.Get(key); found && value != values[key] {
+						t.Fatalf("expected to get %d for key %d, got %d", values[key], key, value)
+					}
+				}
+				if c.Len() > capacity {
+					t.Fatalf("expected at most %d entries, got %d", capacity, c.Len())
+				}
+			}
+		})
+	}
+}
+
+func TestCacheScanResistance(t *testing.T) {
+	const capacity = 100
+	for _, p := range policies()[1:] {
+		t.Run(p.name, func(t *testing.T) {
+			c := p.newCache(capacity)
+			for round := 0; round < 3; round++ {
+				for key := uint64(0); key < capacity/2; key++ {
+					if _, found := c.Get(key); !found {
+						c.Put(key, key)
+					}
+				}
+			}
+			for key := uint64(capacity); key < 20*capacity; key++ {
+				if _, found := c.Get(key); !found {
+					c.Put(key, key)
+				}
+			}
+			// The most recent key may still be in the admission window of W-TinyLFU when the scan
+			// starts, so it's allowed to be lost.
+			lost := 0
+			for key := uint64(0); key < capacity/2; key++ {
+				if !c.Contains(key) {
+					lost++
+				}
+			}
+			if lost > 1 {
+				t.Fatalf("expected the frequently used keys to survive the scan, lost %d", lost)
+			}
+		})
+	}
+}
+
+func TestInvalidCapacity(t *testing.T) {
+	for _, p := range policies()[1:] {
+		t.Run(p.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("expected a panic for a zero capacity")
+				}
+			}()
+			p.newCache(0)
+		})
+	}
+}
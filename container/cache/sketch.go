@@ -0,0 +1,80 @@
+package cache
+
+import "math/bits"
+
+const (
+	sketchDepth = 4
+	// sketchMaxCount is the largest value of a counter, which fits in four bits.
+	sketchMaxCount = 15
+	// sketchSampleFactor is the number of increments, relative to the capacity, after which all
+	// the counters are halved so that the old accesses are gradually forgotten.
+	sketchSampleFactor = 10
+)
+
+var sketchSeeds = [sketchDepth]uint64{
+	0x9e3779b97f4a7c15, 0xbf58476d1ce4e5b9, 0x94d049bb133111eb, 0xc2b2ae3d27d4eb4f,
+}
+
+// countMinSketch estimates the access frequency of the keys using a small fixed amount of memory.
+// The estimates may exceed the real frequencies because of hash collisions, but never fall below
+// them, except for the periodic halving.
+type countMinSketch struct {
+	rows       [sketchDepth][]uint8
+	mask       uint64
+	increments int
+	sampleSize int
+}
+
+func newCountMinSketch(capacity int) *countMinSketch {
+	width := 1 << bits.Len(uint(capacity))
+	if width < 16 {
+		width = 16
+	}
+	s := &countMinSketch{
+		mask:       uint64(width - 1),
+		sampleSize: sketchSampleFactor * capacity,
+	}
+	for i := range s.rows {
+		s.rows[i] = make([]uint8, width)
+	}
+	return s
+}
+
+// increment records an access of the key having the given hash.
+func (s *countMinSketch) increment(hash uint64) {
+	for i := range s.rows {
+		counter := &s.rows[i][s.index(hash, i)]
+		if *counter < sketchMaxCount {
+			*counter++
+		}
+	}
+	s.increments++
+	if s.increments >= s.sampleSize {
+		s.halve()
+	}
+}
+
+// estimate returns the estimated access frequency of the key having the given hash.
+func (s *countMinSketch) estimate(hash uint64) uint8 {
+	estimate := uint8(sketchMaxCount)
+	for i := range s.rows {
+		if counter := s.rows[i][s.index(hash, i)]; counter < estimate {
+			estimate = counter
+		}
+	}
+	return estimate
+}
+
+func (s *countMinSketch) halve() {
+	for i := range s.rows {
+		for j := range s.rows[i] {
+			s.rows[i][j] /= 2
+		}
+	}
+	s.increments /= 2
+}
+
+func (s *countMinSketch) index(hash uint64, row int) uint64 {
+	h := (hash ^ sketchSeeds[row]) * sketchSeeds[(row+1)%sketchDepth]
+	return (h ^ h>>31) & s.mask
+}
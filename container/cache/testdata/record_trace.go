@@ -0,0 +1,80 @@
+//go:build ignore
+
+// This program records the goident.trace.gz trace: the sequence of identifiers found while
+// scanning the non-test sources of the net/http and go/types packages of the Go 1.27.1 standard
+// library, as looked up in the symbol table of a compiler. Each identifier is replaced by the
+// index of its first occurrence and the trace holds one index per line.
+//
+// Usage: go run record_trace.go goident.trace.gz $(go env GOROOT)/src/net/http \
+// $(go env GOROOT)/src/go/types
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	out, err := os.Create(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	zw, err := gzip.NewWriterLevel(out, gzip.BestCompression)
+	if err != nil {
+		log.Fatal(err)
+	}
+	w := bufio.NewWriter(zw)
+
+	ids := make(map[string]int)
+	for _, dir := range os.Args[2:] {
+		files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		sort.Strings(files)
+		for _, file := range files {
+			if strings.HasSuffix(file, "_test.go") {
+				continue
+			}
+			src, err := os.ReadFile(file)
+			if err != nil {
+				log.Fatal(err)
+			}
+			var s scanner.Scanner
+			s.Init(token.NewFileSet().AddFile(file, -1, len(src)), src, nil, 0)
+			for {
+				_, tok, lit := s.Scan()
+				if tok == token.EOF {
+					break
+				}
+				if tok != token.IDENT {
+					continue
+				}
+				id, found := ids[lit]
+				if !found {
+					id = len(ids)
+					ids[lit] = id
+				}
+				fmt.Fprintln(w, id)
+			}
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+	if err := out.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
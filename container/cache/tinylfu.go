@@ -0,0 +1,149 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/playgroundgo/genlib/container/lru"
+	"github.com/playgroundgo/genlib/generic"
+)
+
+const (
+	// tinyLFUWindowRatio is the fraction of the capacity used by the admission window.
+	tinyLFUWindowRatio = 0.01
+	// tinyLFUProtectedRatio is the fraction of the main space used by the protected segment.
+	tinyLFUProtectedRatio = 0.8
+)
+
+// TinyLFU implements the W-TinyLFU cache policy. New entries are admitted into a small window
+// and, when evicted from it, they compete with the least recently used entry of the main space,
+// the one with the higher estimated access frequency being kept. The main space is split into a
+// probation segment and a protected segment holding the entries accessed again while on
+// probation. The access frequencies are estimated using a count-min sketch, which requires a hash
+// function for the keys. A TinyLFU is not safe for concurrent use.
+type TinyLFU[K comparable, V any] struct {
+	hash          func(key K) uint64
+	sketch        *countMinSketch
+	windowSize    int
+	mainSize      int
+	protectedSize int
+	window        *lru.Cache[K, V]
+	probation     *lru.Cache[K, V]
+	protected     *lru.Cache[K, V]
+}
+
+// NewTinyLFU returns an empty W-TinyLFU cache which can hold up to 'capacity' entries and uses
+// the function 'hash' to hash the keys. It panics if the capacity is not positive.
+func NewTinyLFU[K comparable, V any](capacity int, hash func(key K) uint64) *TinyLFU[K, V] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("cache: invalid capacity %d", capacity))
+	}
+	windowSize := generic.Max(int(float64(capacity)*tinyLFUWindowRatio), 1)
+	mainSize := capacity - windowSize
+	return &TinyLFU[K, V]{
+		hash:          hash,
+		sketch:        newCountMinSketch(capacity),
+		windowSize:    windowSize,
+		mainSize:      mainSize,
+		protectedSize: int(float64(mainSize) * tinyLFUProtectedRatio),
+		window:        lru.New[K, V](capacity),
+		probation:     lru.New[K, V](capacity),
+		protected:     lru.New[K, V](capacity),
+	}
+}
+
+// Get returns the value associated with the given key and records the access.
+func (c *TinyLFU[K, V]) Get(key K) (V, bool) {
+	c.sketch.increment(c.hash(key))
+	if value, found := c.window.Get(key); found {
+		return value, true
+	}
+	if value, found := c.protected.Get(key); found {
+		return value, true
+	}
+	if value, found := c.probation.Remove(key); found {
+		c.promote(key, value)
+		return value, true
+	}
+	var tmp V
+	return tmp, false
+}
+
+// Peek returns the value associated with the given key without recording the access.
+func (c *TinyLFU[K, V]) Peek(key K) (V, bool) {
+	if value, found := c.window.Peek(key); found {
+		return value, true
+	}
+	if value, found := c.protected.Peek(key); found {
+		return value, true
+	}
+	return c.probation.Peek(key)
+}
+
+// Contains verifies if the given key is found in the cache without recording the access.
+func (c *TinyLFU[K, V]) Contains(key K) bool {
+	return c.window.Contains(key) || c.protected.Contains(key) || c.probation.Contains(key)
+}
+
+// Put adds a key-value pair to the cache, or replaces the value if the key is already present.
+// A new entry may be rejected later, when leaving the admission window, if it is accessed less
+// frequently than the entries of the main space.
+func (c *TinyLFU[K, V]) Put(key K, value V) {
+	c.sketch.increment(c.hash(key))
+	if c.window.Contains(key) {
+		c.window.Put(key, value)
+		return
+	}
+	if c.protected.Contains(key) {
+		c.protected.Put(key, value)
+		return
+	}
+	if _, found := c.probation.Remove(key); found {
+		c.promote(key, value)
+		return
+	}
+
+	c.window.Put(key, value)
+	if c.window.Len() <= c.windowSize {
+		return
+	}
+	candidate, candidateValue, _ := c.window.RemoveOldest()
+	if c.probation.Len()+c.protected.Len() < c.mainSize {
+		c.probation.Put(candidate, candidateValue)
+		return
+	}
+	victims := c.probation
+	if victims.Len() == 0 {
+		victims = c.protected
+	}
+	victim, _, ok := victims.Oldest()
+	if ok && c.sketch.estimate(c.hash(candidate)) > c.sketch.estimate(c.hash(victim)) {
+		victims.Remove(victim)
+		c.probation.Put(candidate, candidateValue)
+	}
+}
+
+// Remove removes the given key from the cache and returns the value associated with it.
+func (c *TinyLFU[K, V]) Remove(key K) (V, bool) {
+	if value, found := c.window.Remove(key); found {
+		return value, true
+	}
+	if value, found := c.protected.Remove(key); found {
+		return value, true
+	}
+	return c.probation.Remove(key)
+}
+
+// Len returns the number of entries in the cache.
+func (c *TinyLFU[K, V]) Len() int {
+	return c.window.Len() + c.probation.Len() + c.protected.Len()
+}
+
+// promote moves an entry from the probation segment to the protected one, demoting the least
+// recently used protected entry if the protected segment is full.
+func (c *TinyLFU[K, V]) promote(key K, value V) {
+	c.protected.Put(key, value)
+	if c.protected.Len() > c.protectedSize {
+		demoted, demotedValue, _ := c.protected.RemoveOldest()
+		c.probation.Put(demoted, demotedValue)
+	}
+}
@@ -0,0 +1,180 @@
+package cache_test
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/cache"
+	"github.com/playgroundgo/genlib/container/lru"
+)
+
+const traceCapacity = 1000
+
+// trace describes a workload replayed against a cache of the given capacity. The synthetic traces
+// are generated with a fixed seed, so that the hit ratios are reproducible across runs.
+type trace struct {
+	name     string
+	keys     []uint64
+	capacity int
+	// minRatios holds the minimum hit ratio expected from each policy. When missing, the
+	// scan-resistant policies are expected to beat the LRU hit ratio.
+	minRatios map[string]float64
+}
+
+// readTrace reads a recorded trace from a gzip compressed file holding one key per line.
+func readTrace(tb testing.TB, path string) []uint64 {
+	tb.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	keys := make([]uint64, 0)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, err := strconv.ParseUint(scanner.Text(), 10, 64)
+		if err != nil {
+			tb.Fatalf("invalid key in trace %s: %v", path, err)
+		}
+		keys = append(keys, key)
+	}
+	if err := scanner.Err(); err != nil {
+		tb.Fatal(err)
+	}
+	return keys
+}
+
+// zipfTrace returns accesses following a Zipf distribution over the given number of keys.
+func zipfTrace(rnd *rand.Rand, n int, keys uint64) []uint64 {
+	zipf := rand.NewZipf(rnd, 1.1, 1, keys-1)
+	trace := make([]uint64, n)
+	for i := range trace {
+		trace[i] = zipf.Uint64()
+	}
+	return trace
+}
+
+// scanTrace interleaves a Zipf workload with long sequential scans over keys which are never
+// accessed again.
+func scanTrace(rnd *rand.Rand, n int, keys uint64) []uint64 {
+	hot := zipfTrace(rnd, n, keys)
+	trace := make([]uint64, 0, 2*n)
+	next := keys
+	for i, key := range hot {
+		trace = append(trace, key)
+		if i%5000 == 4999 {
+			for j := 0; j < 2*traceCapacity; j++ {
+				trace = append(trace, next)
+				next++
+			}
+		}
+	}
+	return trace
+}
+
+// loopTrace repeatedly accesses a working set slightly larger than the cache capacity, which
+// makes a plain LRU cache miss on every access.
+func loopTrace(n int, keys uint64) []uint64 {
+	trace := make([]uint64, n)
+	for i := range trace {
+		trace[i] = uint64(i) % keys
+	}
+	return trace
+}
+
+func traces(tb testing.TB) []trace {
+	rnd := rand.New(rand.NewSource(42))
+	return []trace{
+		// The identifier lookups favor the recently used keys, so the policies only need to
+		// stay close to the hit ratios measured when the trace was recorded.
+		{name: "goident", keys: readTrace(tb, "testdata/goident.trace.gz"), capacity: 200,
+			minRatios: map[string]float64{"lru": 0.84, "arc": 0.83, "2q": 0.84, "tinylfu": 0.78}},
+		{name: "zipf", keys: zipfTrace(rnd, 200000, 50000), capacity: traceCapacity},
+		{name: "zipf+scans", keys: scanTrace(rnd, 200000, 50000), capacity: traceCapacity},
+		{name: "loop", keys: loopTrace(200000, traceCapacity*5/4), capacity: traceCapacity},
+	}
+}
+
+type policy struct {
+	name     string
+	newCache func(capacity int) cache.Cache[uint64, uint64]
+}
+
+func policies() []policy {
+	return []policy{
+		{name: "lru", newCache: func(capacity int) cache.Cache[uint64, uint64] {
+			return lru.New[uint64, uint64](capacity)
+		}},
+		{name: "arc", newCache: func(capacity int) cache.Cache[uint64, uint64] {
+			return cache.NewARC[uint64, uint64](capacity)
+		}},
+		{name: "2q", newCache: func(capacity int) cache.Cache[uint64, uint64] {
+			return cache.NewTwoQueue[uint64, uint64](capacity)
+		}},
+		{name: "tinylfu", newCache: func(capacity int) cache.Cache[uint64, uint64] {
+			return cache.NewTinyLFU[uint64, uint64](capacity, hashUint64)
+		}},
+	}
+}
+
+func hashUint64(key uint64) uint64 {
+	key ^= key >> 33
+	key *= 0xff51afd7ed558ccd
+	key ^= key >> 33
+	return key
+}
+
+// hitRatio replays the trace, adding the missing keys to the cache, and returns the fraction of
+// the accesses which found the key in the cache.
+func hitRatio(c cache.Cache[uint64, uint64], keys []uint64) float64 {
+	hits := 0
+	for _, key := range keys {
+		if _, found := c.Get(key); found {
+			hits++
+		} else {
+			c.Put(key, key)
+		}
+	}
+	return float64(hits) / float64(len(keys))
+}
+
+func TestHitRatio(t *testing.T) {
+	for _, tr := range traces(t) {
+		lruRatio := hitRatio(lru.New[uint64, uint64](tr.capacity), tr.keys)
+		for _, p := range policies() {
+			ratio := hitRatio(p.newCache(tr.capacity), tr.keys)
+			if minRatio, found := tr.minRatios[p.name]; found {
+				if ratio < minRatio {
+					t.Errorf("expected %s to reach the hit ratio %.4f on the %s trace, got %.4f",
+						p.name, minRatio, tr.name, ratio)
+				}
+			} else if p.name != "lru" && ratio <= lruRatio {
+				t.Errorf("expected %s to beat the LRU hit ratio %.4f on the %s trace, got %.4f",
+					p.name, lruRatio, tr.name, ratio)
+			}
+		}
+	}
+}
+
+func BenchmarkHitRatio(b *testing.B) {
+	for _, tr := range traces(b) {
+		for _, p := range policies() {
+			b.Run(fmt.Sprintf("%s/%s", tr.name, p.name), func(b *testing.B) {
+				var ratio float64
+				for i := 0; i < b.N; i++ {
+					ratio = hitRatio(p.newCache(tr.capacity), tr.keys)
+				}
+				b.ReportMetric(100*ratio, "hit%")
+			})
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/playgroundgo/genlib/container/lru"
+	"github.com/playgroundgo/genlib/generic"
+)
+
+const (
+	// twoQueueRecentRatio is the fraction of the capacity reserved to the entries accessed once.
+	twoQueueRecentRatio = 0.25
+	// twoQueueGhostRatio is the number of evicted keys remembered, relative to the capacity.
+	twoQueueGhostRatio = 0.5
+)
+
+// TwoQueue implements the 2Q cache policy. New entries are admitted into a small queue and are
+// promoted to the main queue only when they are accessed again, either while still in the small
+// queue or shortly after being evicted from it. A single pass over many keys can only evict the
+// entries of the small queue, so the cache is resistant to scans. A TwoQueue is not safe for
+// concurrent use.
+type TwoQueue[K comparable, V any] struct {
+	capacity   int
+	recentSize int
+	recent     *lru.Cache[K, V]
+	frequent   *lru.Cache[K, V]
+	ghosts     *lru.Cache[K, struct{}]
+}
+
+// NewTwoQueue returns an empty 2Q cache which can hold up to 'capacity' entries. It panics if the
+// capacity is not positive.
+func NewTwoQueue[K comparable, V any](capacity int) *TwoQueue[K, V] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("cache: invalid capacity %d", capacity))
+	}
+	return &TwoQueue[K, V]{
+		capacity:   capacity,
+		recentSize: int(float64(capacity) * twoQueueRecentRatio),
+		recent:     lru.New[K, V](capacity),
+		frequent:   lru.New[K, V](capacity),
+		ghosts:     lru.New[K, struct{}](generic.Max(int(float64(capacity)*twoQueueGhostRatio), 1)),
+	}
+}
+
+// Get returns the value associated with the given key and records the access.
+func (c *TwoQueue[K, V]) Get(key K) (V, bool) {
+	if value, found := c.frequent.Get(key); found {
+		return value, true
+	}
+	if value, found := c.recent.Remove(key); found {
+		c.frequent.Put(key, value)
+		return value, true
+	}
+	var tmp V
+	return tmp, false
+}
+
+// Peek returns the value associated with the given key without recording the access.
+func (c *TwoQueue[K, V]) Peek(key K) (V, bool) {
+	if value, found := c.frequent.Peek(key); found {
+		return value, true
+	}
+	return c.recent.Peek(key)
+}
+
+// Contains verifies if the given key is found in the cache without recording the access.
+func (c *TwoQueue[K, V]) Contains(key K) bool {
+	return c.frequent.Contains(key) || c.recent.Contains(key)
+}
+
+// Put adds a key-value pair to the cache, or replaces the value if the key is already present.
+func (c *TwoQueue[K, V]) Put(key K, value V) {
+	if c.frequent.Contains(key) {
+		c.frequent.Put(key, value)
+		return
+	}
+	if _, found := c.recent.Remove(key); found {
+		c.frequent.Put(key, value)
+		return
+	}
+	if _, found := c.ghosts.Remove(key); found {
+		c.makeSpace(true)
+		c.frequent.Put(key, value)
+		return
+	}
+	c.makeSpace(false)
+	c.recent.Put(key, value)
+}
+
+// Remove removes the given key from the cache and returns the value associated with it.
+func (c *TwoQueue[K, V]) Remove(key K) (V, bool) {
+	c.ghosts.Remove(key)
+	if value, found := c.frequent.Remove(key); found {
+		return value, true
+	}
+	return c.recent.Remove(key)
+}
+
+// Len returns the number of entries in the cache.
+func (c *TwoQueue[K, V]) Len() int {
+	return c.recent.Len() + c.frequent.Len()
+}
+
+// makeSpace evicts an entry if the cache is full. The entries accessed once are evicted first as
+// long as their queue exceeds its reserved size.
+func (c *TwoQueue[K, V]) makeSpace(ghostHit bool) {
+	if c.Len() < c.capacity {
+		return
+	}
+	recentLen := c.recent.Len()
+	if recentLen > 0 && (recentLen > c.recentSize || (recentLen == c.recentSize && !ghostHit)) {
+		key, _, _ := c.recent.RemoveOldest()
+		c.ghosts.Put(key, struct{}{})
+		return
+	}
+	c.frequent.RemoveOldest()
+}
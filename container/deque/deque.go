@@ -0,0 +1,164 @@
+package deque
+
+import (
+	"fmt"
+
+	"github.com/playgroundgo/genlib/errors"
+)
+
+// minCapacity is the smallest capacity of the ring buffer once elements were added.
+const minCapacity = 16
+
+// Deque implements a double-ended queue backed by a ring buffer whose capacity is always a power
+// of two. The buffer grows when it is full and shrinks when it is mostly empty, so the memory
+// used is proportional to the number of elements. The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+// New returns an empty deque.
+func New[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// Len returns the number of elements in the deque.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// IsEmpty returns 'true' if the deque has no elements.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// PushFront adds an element at the front of the deque.
+func (d *Deque[T]) PushFront(item T) {
+	d.grow()
+	d.head = d.index(-1)
+	d.buf[d.head] = item
+	d.size++
+}
+
+// PushBack adds an element at the back of the deque.
+func (d *Deque[T]) PushBack(item T) {
+	d.grow()
+	d.buf[d.index(d.size)] = item
+	d.size++
+}
+
+// PopFront removes the element at the front of the deque and returns it.
+func (d *Deque[T]) PopFront() (T, error) {
+	if d.size == 0 {
+		var tmp T
+		return tmp, errors.ErrEmpty
+	}
+	item := d.take(d.head)
+	d.head = d.index(1)
+	d.size--
+	d.shrink()
+	return item, nil
+}
+
+// PopBack removes the element at the back of the deque and returns it.
+func (d *Deque[T]) PopBack() (T, error) {
+	if d.size == 0 {
+		var tmp T
+		return tmp, errors.ErrEmpty
+	}
+	item := d.take(d.index(d.size - 1))
+	d.size--
+	d.shrink()
+	return item, nil
+}
+
+// Front returns the element at the front of the deque without removing it.
+func (d *Deque[T]) Front() (T, error) {
+	if d.size == 0 {
+		var tmp T
+		return tmp, errors.ErrEmpty
+	}
+	return d.buf[d.head], nil
+}
+
+// Back returns the element at the back of the deque without removing it.
+func (d *Deque[T]) Back() (T, error) {
+	if d.size == 0 {
+		var tmp T
+		return tmp, errors.ErrEmpty
+	}
+	return d.buf[d.index(d.size-1)], nil
+}
+
+// At returns the element found at index 'i', counting from the front of the deque. It panics if
+// the index is out of range.
+func (d *Deque[T]) At(i int) T {
+	if i < 0 || i >= d.size {
+		panic(fmt.Sprintf("deque: index %d out of range [0, %d)", i, d.size))
+	}
+	return d.buf[d.index(i)]
+}
+
+// Clear removes all the elements from the deque and releases the buffer.
+func (d *Deque[T]) Clear() {
+	*d = Deque[T]{}
+}
+
+// ToSlice returns a slice containing all the elements from the deque, from front to back.
+func (d *Deque[T]) ToSlice() []T {
+	items := make([]T, d.size)
+	d.copyTo(items)
+	return items
+}
+
+// index returns the buffer position of the element found at offset 'i' from the head.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) & (len(d.buf) - 1)
+}
+
+// take returns the element at the given buffer position, clearing the position so that the
+// element can be garbage collected.
+func (d *Deque[T]) take(pos int) T {
+	var zero T
+	item := d.buf[pos]
+	d.buf[pos] = zero
+	return item
+}
+
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+	if len(d.buf) == 0 {
+		d.buf = make([]T, minCapacity)
+		return
+	}
+	d.resize(len(d.buf) * 2)
+}
+
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minCapacity && d.size <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+func (d *Deque[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	d.copyTo(buf)
+	d.buf = buf
+	d.head = 0
+}
+
+// copyTo copies the elements, from front to back, to the beginning of the destination slice.
+func (d *Deque[T]) copyTo(dst []T) {
+	if d.size == 0 {
+		return
+	}
+	if tail := d.head + d.size; tail <= len(d.buf) {
+		copy(dst, d.buf[d.head:tail])
+	} else {
+		n := copy(dst, d.buf[d.head:])
+		copy(dst[n:], d.buf[:tail-len(d.buf)])
+	}
+}
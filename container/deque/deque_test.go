@@ -0,0 +1,119 @@
+package deque_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/deque"
+	gerrors "github.com/playgroundgo/genlib/errors"
+	"golang.org/x/exp/slices"
+)
+
+func TestDequeOperations(t *testing.T) {
+	var d deque.Deque[int]
+	if _, err := d.PopFront(); !errors.Is(err, gerrors.ErrEmpty) {
+		t.Fatalf("expected empty container error, got %v", err)
+	}
+	if _, err := d.PopBack(); !errors.Is(err, gerrors.ErrEmpty) {
+		t.Fatalf("expected empty container error, got %v", err)
+	}
+	if _, err := d.Front(); !errors.Is(err, gerrors.ErrEmpty) {
+		t.Fatalf("expected empty container error, got %v", err)
+	}
+	if _, err := d.Back(); !errors.Is(err, gerrors.ErrEmpty) {
+		t.Fatalf("expected empty container error, got %v", err)
+	}
+
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+
+	if expected := []int{0, 1, 2, 3}; !slices.Equal(d.ToSlice(), expected) {
+		t.Fatalf("expected %v, got %v", expected, d.ToSlice())
+	}
+	if front, _ := d.Front(); front != 0 {
+		t.Fatalf("expected front element 0, got %d", front)
+	}
+	if back, _ := d.Back(); back != 3 {
+		t.Fatalf("expected back element 3, got %d", back)
+	}
+	if d.At(2) != 2 {
+		t.Fatalf("expected element 2 at index 2, got %d", d.At(2))
+	}
+	if item, _ := d.PopFront(); item != 0 {
+		t.Fatalf("expected to pop 0 from the front, got %d", item)
+	}
+	if item, _ := d.PopBack(); item != 3 {
+		t.Fatalf("expected to pop 3 from the back, got %d", item)
+	}
+	if d.Len() != 2 {
+		t.Fatalf("expected 2 elements in the deque, got %d", d.Len())
+	}
+
+	d.Clear()
+	if !d.IsEmpty() {
+		t.Fatal("expected the deque to be empty")
+	}
+}
+
+func TestDequeAtOutOfRange(t *testing.T) {
+	d := deque.New[int]()
+	d.PushBack(1)
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected At to panic for an index out of range")
+		}
+	}()
+	d.At(1)
+}
+
+func TestDequeAgainstSlice(t *testing.T) {
+	rnd := rand.New(rand.NewSource(13))
+	d := deque.New[int]()
+	expected := make([]int, 0)
+
+	for i := 0; i < 20000; i++ {
+		// Bias the operations towards growing in the first half and shrinking in the second one.
+		grow := rnd.Intn(10) < 6
+		if i >= 10000 {
+			grow = !grow
+		}
+		switch {
+		case grow && rnd.Intn(2) == 0:
+			d.PushFront(i)
+			expected = append([]int{i}, expected...)
+		case grow:
+			d.PushBack(i)
+			expected = append(expected, i)
+		case rnd.Intn(2) == 0:
+			item, err := d.PopFront()
+			if len(expected) > 0 {
+				if err != nil || item != expected[0] {
+					t.Fatalf("expected to pop %d from the front, got %d (%v)", expected[0], item,
+						err)
+				}
+				expected = expected[1:]
+			}
+		default:
+			item, err := d.PopBack()
+			if len(expected) > 0 {
+				if err != nil || item != expected[len(expected)-1] {
+					t.Fatalf("expected to pop %d from the back, got %d (%v)",
+						expected[len(expected)-1], item, err)
+				}
+				expected = expected[:len(expected)-1]
+			}
+		}
+		if d.Len() != len(expected) {
+			t.Fatalf("expected %d elements in the deque, got %d", len(expected), d.Len())
+		}
+		if len(expected) > 0 && d.At(len(expected)/2) != expected[len(expected)/2] {
+			t.Fatalf("unexpected element at index %d", len(expected)/2)
+		}
+	}
+	if !slices.Equal(d.ToSlice(), expected) {
+		t.Fatalf("expected %v, got %v", expected, d.ToSlice())
+	}
+}
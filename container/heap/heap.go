@@ -0,0 +1,129 @@
+package heap
+
+import (
+	"github.com/playgroundgo/genlib/errors"
+	"github.com/playgroundgo/genlib/generic"
+)
+
+// Heap implements a binary min-heap ordered by the provided comparator function. The element
+// for which the comparator reports that it is less than all the others is found at the top.
+type Heap[T any] struct {
+	items []T
+	less  generic.LessFn[T]
+}
+
+// New returns an empty heap.
+func New[T any](less generic.LessFn[T]) *Heap[T] {
+	return &Heap[T]{
+		less: less,
+	}
+}
+
+// FromSlice builds a heap holding a copy of the given items in linear time.
+func FromSlice[T any](less generic.LessFn[T], items []T) *Heap[T] {
+	h := &Heap[T]{
+		items: append([]T(nil), items...),
+		less:  less,
+	}
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return h
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// IsEmpty returns 'true' if the heap has no elements.
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+// Push adds an element to the heap.
+func (h *Heap[T]) Push(item T) {
+	h.items = append(h.items, item)
+	h.up(len(h.items) - 1)
+}
+
+// Pop removes the top element of the heap and returns it.
+func (h *Heap[T]) Pop() (T, error) {
+	if len(h.items) == 0 {
+		var tmp T
+		return tmp, errors.ErrEmpty
+	}
+	last := len(h.items) - 1
+	top := h.items[0]
+	h.items[0] = h.items[last]
+	var zero T
+	h.items[last] = zero
+	h.items = h.items[:last]
+	h.down(0)
+	return top, nil
+}
+
+// Peek returns the top element of the heap without removing it.
+func (h *Heap[T]) Peek() (T, error) {
+	if len(h.items) == 0 {
+		var tmp T
+		return tmp, errors.ErrEmpty
+	}
+	return h.items[0], nil
+}
+
+// PushPop adds an element to the heap and then removes the top element and returns it. It is
+// more efficient than calling Push followed by Pop.
+func (h *Heap[T]) PushPop(item T) T {
+	if len(h.items) == 0 || !h.less(h.items[0], item) {
+		return item
+	}
+	item, h.items[0] = h.items[0], item
+	h.down(0)
+	return item
+}
+
+// Replace removes the top element of the heap and then adds the given element, returning the
+// removed one. It is more efficient than calling Pop followed by Push.
+func (h *Heap[T]) Replace(item T) (T, error) {
+	if len(h.items) == 0 {
+		var tmp T
+		return tmp, errors.ErrEmpty
+	}
+	item, h.items[0] = h.items[0], item
+	h.down(0)
+	return item, nil
+}
+
+// Clear removes all the elements from the heap.
+func (h *Heap[T]) Clear() {
+	h.items = nil
+}
+
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.items[i], h.items[parent]) {
+			return
+		}
+		h.items[i], h.items[parent] = h.items[parent], h.items[i]
+		i = parent
+	}
+}
+
+func (h *Heap[T]) down(i int) {
+	n := len(h.items)
+	for {
+		smallest := i
+		for _, child := range [2]int{2*i + 1, 2*i + 2} {
+			if child < n && h.less(h.items[child], h.items[smallest]) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return
+		}
+		h.items[i], h.items[smallest] = h.items[smallest], h.items[i]
+		i = smallest
+	}
+}
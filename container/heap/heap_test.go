@@ -0,0 +1,89 @@
+package heap_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/heap"
+	gerrors "github.com/playgroundgo/genlib/errors"
+	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/slices"
+)
+
+func TestHeapOrdering(t *testing.T) {
+	rnd := rand.New(rand.NewSource(5))
+	items := make([]int, 500)
+	for i := range items {
+		items[i] = rnd.Intn(100)
+	}
+
+	h := heap.New(generic.Less[int])
+	for _, item := range items {
+		h.Push(item)
+	}
+	fromSlice := heap.FromSlice(generic.Less[int], items)
+	if h.Len() != len(items) || fromSlice.Len() != len(items) {
+		t.Fatalf("expected %d elements in the heaps, got %d and %d", len(items), h.Len(),
+			fromSlice.Len())
+	}
+
+	sorted := slices.Clone(items)
+	slices.Sort(sorted)
+	for _, want := range sorted {
+		if top, _ := h.Peek(); top != want {
+			t.Fatalf("expected top element %d, got %d", want, top)
+		}
+		got, err := h.Pop()
+		if err != nil || got != want {
+			t.Fatalf("expected to pop %d, got %d (%v)", want, got, err)
+		}
+		if got, _ := fromSlice.Pop(); got != want {
+			t.Fatalf("expected to pop %d from the heapified slice, got %d", want, got)
+		}
+	}
+	if !h.IsEmpty() {
+		t.Fatal("expected the heap to be empty")
+	}
+}
+
+func TestEmptyHeap(t *testing.T) {
+	h := heap.New(generic.Less[int])
+	if _, err := h.Pop(); !errors.Is(err, gerrors.ErrEmpty) {
+		t.Fatalf("expected empty container error, got %v", err)
+	}
+	if _, err := h.Peek(); !errors.Is(err, gerrors.ErrEmpty) {
+		t.Fatalf("expected empty container error, got %v", err)
+	}
+	if _, err := h.Replace(1); !errors.Is(err, gerrors.ErrEmpty) {
+		t.Fatalf("expected empty container error, got %v", err)
+	}
+	if got := h.PushPop(1); got != 1 || !h.IsEmpty() {
+		t.Fatalf("expected PushPop on an empty heap to return 1, got %d", got)
+	}
+}
+
+func TestPushPopReplace(t *testing.T) {
+	h := heap.FromSlice(func(a, b string) bool { return len(a) > len(b) },
+		[]string{"a", "bbb", "cc"})
+
+	if got := h.PushPop("dddd"); got != "dddd" {
+		t.Fatalf("expected PushPop to return the pushed element, got %s", got)
+	}
+	if got := h.PushPop("ee"); got != "bbb" {
+		t.Fatalf("expected PushPop to return bbb, got %s", got)
+	}
+	if got, _ := h.Replace("ffffff"); got != "cc" && got != "ee" {
+		t.Fatalf("expected Replace to return one of the longest strings, got %s", got)
+	}
+	if top, _ := h.Peek(); top != "ffffff" {
+		t.Fatalf("expected top element ffffff, got %s", top)
+	}
+	if h.Len() != 3 {
+		t.Fatalf("expected 3 elements in the heap, got %d", h.Len())
+	}
+	h.Clear()
+	if !h.IsEmpty() {
+		t.Fatal("expected the heap to be empty")
+	}
+}
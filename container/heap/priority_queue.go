@@ -0,0 +1,166 @@
+package heap
+
+import (
+	"github.com/playgroundgo/genlib/errors"
+	"github.com/playgroundgo/genlib/generic"
+)
+
+// Item is a handle to a value held by a priority queue, which can be used to update its priority
+// or to remove it from the queue.
+type Item[V, P any] struct {
+	value    V
+	priority P
+	index    int
+	queue    *PriorityQueue[V, P]
+}
+
+// Value returns the value held by the item.
+func (it *Item[V, P]) Value() V {
+	return it.value
+}
+
+// Priority returns the current priority of the item.
+func (it *Item[V, P]) Priority() P {
+	return it.priority
+}
+
+// PriorityQueue implements an indexed priority queue, which allows updating the priority of the
+// queued values and removing them through the handles returned when they are pushed. The value
+// for which the comparator reports the smallest priority is found at the top.
+type PriorityQueue[V, P any] struct {
+	items []*Item[V, P]
+	less  generic.LessFn[P]
+}
+
+// NewPriorityQueue returns an empty priority queue.
+func NewPriorityQueue[V, P any](less generic.LessFn[P]) *PriorityQueue[V, P] {
+	return &PriorityQueue[V, P]{
+		less: less,
+	}
+}
+
+// Len returns the number of values in the queue.
+func (q *PriorityQueue[V, P]) Len() int {
+	return len(q.items)
+}
+
+// IsEmpty returns 'true' if the queue has no values.
+func (q *PriorityQueue[V, P]) IsEmpty() bool {
+	return len(q.items) == 0
+}
+
+// Push adds a value with the given priority to the queue and returns its handle.
+func (q *PriorityQueue[V, P]) Push(value V, priority P) *Item[V, P] {
+	item := &Item[V, P]{
+		value:    value,
+		priority: priority,
+		index:    len(q.items),
+		queue:    q,
+	}
+	q.items = append(q.items, item)
+	q.up(item.index)
+	return item
+}
+
+// Pop removes the value having the smallest priority from the queue and returns its handle.
+func (q *PriorityQueue[V, P]) Pop() (*Item[V, P], error) {
+	if len(q.items) == 0 {
+		return nil, errors.ErrEmpty
+	}
+	item := q.items[0]
+	q.remove(item)
+	return item, nil
+}
+
+// Peek returns the handle of the value having the smallest priority without removing it.
+func (q *PriorityQueue[V, P]) Peek() (*Item[V, P], error) {
+	if len(q.items) == 0 {
+		return nil, errors.ErrEmpty
+	}
+	return q.items[0], nil
+}
+
+// Contains verifies if the item is still held by the queue.
+func (q *PriorityQueue[V, P]) Contains(item *Item[V, P]) bool {
+	return item != nil && item.queue == q
+}
+
+// Update changes the priority of an item held by the queue. It returns 'false' if the item is
+// not held by the queue.
+func (q *PriorityQueue[V, P]) Update(item *Item[V, P], priority P) bool {
+	if !q.Contains(item) {
+		return false
+	}
+	item.priority = priority
+	q.fix(item.index)
+	return true
+}
+
+// Remove removes an item from the queue. It returns 'false' if the item is not held by the
+// queue.
+func (q *PriorityQueue[V, P]) Remove(item *Item[V, P]) bool {
+	if !q.Contains(item) {
+		return false
+	}
+	q.remove(item)
+	return true
+}
+
+func (q *PriorityQueue[V, P]) remove(item *Item[V, P]) {
+	last := len(q.items) - 1
+	i := item.index
+	q.swap(i, last)
+	q.items[last] = nil
+	q.items = q.items[:last]
+	if i < last {
+		q.fix(i)
+	}
+	item.index = -1
+	item.queue = nil
+}
+
+func (q *PriorityQueue[V, P]) fix(i int) {
+	if !q.down(i) {
+		q.up(i)
+	}
+}
+
+func (q *PriorityQueue[V, P]) swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+	q.items[i].index = i
+	q.items[j].index = j
+}
+
+func (q *PriorityQueue[V, P]) lessAt(i, j int) bool {
+	return q.less(q.items[i].priority, q.items[j].priority)
+}
+
+func (q *PriorityQueue[V, P]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.lessAt(i, parent) {
+			return
+		}
+		q.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the item at index 'i' down the heap and returns 'true' if it was moved.
+func (q *PriorityQueue[V, P]) down(i int) bool {
+	start := i
+	n := len(q.items)
+	for {
+		smallest := i
+		for _, child := range [2]int{2*i + 1, 2*i + 2} {
+			if child < n && q.lessAt(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return i != start
+		}
+		q.swap(i, smallest)
+		i = smallest
+	}
+}
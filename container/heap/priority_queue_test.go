@@ -0,0 +1,93 @@
+package heap_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/heap"
+	gerrors "github.com/playgroundgo/genlib/errors"
+	"github.com/playgroundgo/genlib/generic"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	q := heap.NewPriorityQueue[string, int](generic.Less[int])
+	if _, err := q.Pop(); !errors.Is(err, gerrors.ErrEmpty) {
+		t.Fatalf("expected empty container error, got %v", err)
+	}
+
+	a := q.Push("a", 5)
+	b := q.Push("b", 3)
+	c := q.Push("c", 8)
+	q.Push("d", 1)
+
+	if top, _ := q.Peek(); top.Value() != "d" {
+		t.Fatalf("expected top value d, got %s", top.Value())
+	}
+	if !q.Update(c, 0) {
+		t.Fatal("expected to update the priority of c")
+	}
+	if top, _ := q.Peek(); top.Value() != "c" || top.Priority() != 0 {
+		t.Fatalf("expected top value c with priority 0, got %s", top.Value())
+	}
+	if !q.Update(c, 10) || !q.Remove(b) || q.Contains(b) || q.Remove(b) {
+		t.Fatal("unexpected update or removal result")
+	}
+
+	expected := []string{"d", "a", "c"}
+	for _, want := range expected {
+		item, err := q.Pop()
+		if err != nil || item.Value() != want {
+			t.Fatalf("expected to pop %s, got %v (%v)", want, item, err)
+		}
+		if q.Contains(item) || q.Update(item, 0) {
+			t.Fatal("didn't expected a popped item to be held by the queue")
+		}
+	}
+	if !q.IsEmpty() || q.Contains(a) {
+		t.Fatal("expected the queue to be empty")
+	}
+
+	other := heap.NewPriorityQueue[string, int](generic.Less[int])
+	item := other.Push("x", 1)
+	if q.Contains(item) || q.Remove(item) {
+		t.Fatal("didn't expected the queue to hold an item of another queue")
+	}
+}
+
+func TestPriorityQueueRandomUpdates(t *testing.T) {
+	rnd := rand.New(rand.NewSource(9))
+	q := heap.NewPriorityQueue[int, int](generic.Less[int])
+	items := make([]*heap.Item[int, int], 0)
+	priorities := make(map[int]int)
+
+	for i := 0; i < 1000; i++ {
+		items = append(items, q.Push(i, rnd.Intn(1000)))
+	}
+	for i := 0; i < 2000; i++ {
+		item := items[rnd.Intn(len(items))]
+		if rnd.Intn(4) == 0 {
+			q.Remove(item)
+		} else {
+			q.Update(item, rnd.Intn(1000))
+		}
+	}
+	for _, item := range items {
+		if q.Contains(item) {
+			priorities[item.Value()] = item.Priority()
+		}
+	}
+	if q.Len() != len(priorities) {
+		t.Fatalf("expected %d values in the queue, got %d", len(priorities), q.Len())
+	}
+
+	prev := -1
+	for !q.IsEmpty() {
+		item, _ := q.Pop()
+		if item.Priority() < prev || priorities[item.Value()] != item.Priority() {
+			t.Fatalf("unexpected item %d with priority %d after %d", item.Value(), item.Priority(),
+				prev)
+		}
+		prev = item.Priority()
+	}
+}
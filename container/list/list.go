@@ -0,0 +1,202 @@
+package list
+
+// Element is an element of a linked list. It stays valid, and keeps its position relative to the
+// other elements, until it is removed from the list.
+type Element[T any] struct {
+	// Value is the value stored in the element.
+	Value T
+
+	prev, next *Element[T]
+	list       *List[T]
+}
+
+// Next returns the next element of the list, or nil if this is the last element.
+func (e *Element[T]) Next() *Element[T] {
+	if e.list == nil || e.next == &e.list.root {
+		return nil
+	}
+	return e.next
+}
+
+// Prev returns the previous element of the list, or nil if this is the first element.
+func (e *Element[T]) Prev() *Element[T] {
+	if e.list == nil || e.prev == &e.list.root {
+		return nil
+	}
+	return e.prev
+}
+
+// List implements a doubly linked list. The operations receiving an element do nothing if the
+// element doesn't belong to the list. The zero value is an empty list ready to use.
+type List[T any] struct {
+	// root is a sentinel element, which is both before the first element and after the last one.
+	root Element[T]
+	len  int
+}
+
+// New returns an empty list.
+func New[T any]() *List[T] {
+	return &List[T]{}
+}
+
+// Len returns the number of elements in the list.
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// IsEmpty returns 'true' if the list has no elements.
+func (l *List[T]) IsEmpty() bool {
+	return l.len == 0
+}
+
+// Front returns the first element of the list, or nil if the list is empty.
+func (l *List[T]) Front() *Element[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Back returns the last element of the list, or nil if the list is empty.
+func (l *List[T]) Back() *Element[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// PushFront adds a new element holding the given value at the front of the list and returns it.
+func (l *List[T]) PushFront(value T) *Element[T] {
+	l.lazyInit()
+	return l.insert(&Element[T]{Value: value}, &l.root)
+}
+
+// PushBack adds a new element holding the given value at the back of the list and returns it.
+func (l *List[T]) PushBack(value T) *Element[T] {
+	l.lazyInit()
+	return l.insert(&Element[T]{Value: value}, l.root.prev)
+}
+
+// InsertBefore adds a new element holding the given value right before the element 'mark' and
+// returns it. It returns nil if 'mark' doesn't belong to the list.
+func (l *List[T]) InsertBefore(value T, mark *Element[T]) *Element[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(&Element[T]{Value: value}, mark.prev)
+}
+
+// InsertAfter adds a new element holding the given value right after the element 'mark' and
+// returns it. It returns nil if 'mark' doesn't belong to the list.
+func (l *List[T]) InsertAfter(value T, mark *Element[T]) *Element[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(&Element[T]{Value: value}, mark)
+}
+
+// MoveToFront moves the given element to the front of the list.
+func (l *List[T]) MoveToFront(e *Element[T]) {
+	if e.list != l || l.root.next == e {
+		return
+	}
+	l.insert(l.unlink(e), &l.root)
+}
+
+// MoveToBack moves the given element to the back of the list.
+func (l *List[T]) MoveToBack(e *Element[T]) {
+	if e.list != l || l.root.prev == e {
+		return
+	}
+	l.insert(l.unlink(e), l.root.prev)
+}
+
+// Remove removes the given element from the list and returns its value. The element can't be
+// used with the list afterwards.
+func (l *List[T]) Remove(e *Element[T]) T {
+	if e.list == l {
+		l.unlink(e)
+		e.prev, e.next, e.list = nil, nil, nil
+	}
+	return e.Value
+}
+
+// Clear removes all the elements from the list.
+func (l *List[T]) Clear() {
+	for e := l.Front(); e != nil; {
+		next := e.Next()
+		e.prev, e.next, e.list = nil, nil, nil
+		e = next
+	}
+	l.root.prev, l.root.next = &l.root, &l.root
+	l.len = 0
+}
+
+// ForEach calls the 'f' function for each value in the list, from front to back, while the
+// function returns true.
+func (l *List[T]) ForEach(f func(value T) bool) {
+	for e := l.Front(); e != nil; e = e.Next() {
+		if !f(e.Value) {
+			return
+		}
+	}
+}
+
+// ForEachReverse calls the 'f' function for each value in the list, from back to front, while the
+// function returns true.
+func (l *List[T]) ForEachReverse(f func(value T) bool) {
+	for e := l.Back(); e != nil; e = e.Prev() {
+		if !f(e.Value) {
+			return
+		}
+	}
+}
+
+// Reverse reverses the order of the elements in the list. The elements remain valid.
+func (l *List[T]) Reverse() {
+	if l.len == 0 {
+		return
+	}
+	e := &l.root
+	for {
+		e.prev, e.next = e.next, e.prev
+		e = e.prev
+		if e == &l.root {
+			return
+		}
+	}
+}
+
+// ToSlice returns a slice containing all the values from the list, from front to back.
+func (l *List[T]) ToSlice() []T {
+	values := make([]T, 0, l.len)
+	l.ForEach(func(value T) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+func (l *List[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.prev, l.root.next = &l.root, &l.root
+	}
+}
+
+// insert links the element 'e' right after the element 'at'.
+func (l *List[T]) insert(e, at *Element[T]) *Element[T] {
+	e.prev, e.next = at, at.next
+	at.next.prev = e
+	at.next = e
+	e.list = l
+	l.len++
+	return e
+}
+
+// unlink removes the element 'e' from the chain of elements.
+func (l *List[T]) unlink(e *Element[T]) *Element[T] {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	l.len--
+	return e
+}
@@ -0,0 +1,111 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/list"
+	"golang.org/x/exp/slices"
+)
+
+func checkList[T comparable](t *testing.T, l *list.List[T], expected []T) {
+	t.Helper()
+	if values := l.ToSlice(); !slices.Equal(values, expected) || l.Len() != len(expected) {
+		t.Fatalf("expected list %v, got %v", expected, values)
+	}
+	reversed := make([]T, 0, l.Len())
+	for e := l.Back(); e != nil; e = e.Prev() {
+		reversed = append(reversed, e.Value)
+	}
+	for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+		reversed[i], reversed[j] = reversed[j], reversed[i]
+	}
+	if !slices.Equal(reversed, expected) {
+		t.Fatalf("expected backward links %v, got %v", expected, reversed)
+	}
+}
+
+func TestList(t *testing.T) {
+	var l list.List[int]
+	if l.Front() != nil || l.Back() != nil || !l.IsEmpty() {
+		t.Fatal("expected the zero list to be empty")
+	}
+
+	two := l.PushBack(2)
+	one := l.PushFront(1)
+	four := l.PushBack(4)
+	three := l.InsertBefore(3, four)
+	l.InsertAfter(5, four)
+	checkList(t, &l, []int{1, 2, 3, 4, 5})
+	if l.Front() != one || one.Next() != two || three.Prev() != two || l.Back().Next() != nil {
+		t.Fatal("unexpected element links")
+	}
+
+	l.MoveToFront(three)
+	checkList(t, &l, []int{3, 1, 2, 4, 5})
+	l.MoveToBack(one)
+	checkList(t, &l, []int{3, 2, 4, 5, 1})
+
+	if value := l.Remove(four); value != 4 {
+		t.Fatalf("expected to remove 4, got %d", value)
+	}
+	checkList(t, &l, []int{3, 2, 5, 1})
+	if four.Next() != nil || four.Prev() != nil {
+		t.Fatal("expected the removed element to be detached")
+	}
+	l.Remove(four)
+	l.MoveToFront(four)
+	if l.InsertAfter(6, four) != nil || l.InsertBefore(6, four) != nil {
+		t.Fatal("didn't expected to insert next to a removed element")
+	}
+	checkList(t, &l, []int{3, 2, 5, 1})
+
+	other := list.New[int]()
+	foreign := other.PushBack(10)
+	l.MoveToBack(foreign)
+	l.Remove(foreign)
+	checkList(t, &l, []int{3, 2, 5, 1})
+	checkList(t, other, []int{10})
+
+	l.Clear()
+	checkList(t, &l, []int{})
+	l.PushBack(7)
+	checkList(t, &l, []int{7})
+}
+
+func TestListIteration(t *testing.T) {
+	l := list.New[string]()
+	for _, value := range []string{"a", "b", "c", "d"} {
+		l.PushBack(value)
+	}
+
+	values := make([]string, 0)
+	l.ForEach(func(value string) bool {
+		values = append(values, value)
+		return value != "b"
+	})
+	if !slices.Equal(values, []string{"a", "b"}) {
+		t.Fatalf("expected to stop after b, got %v", values)
+	}
+
+	values = values[:0]
+	l.ForEachReverse(func(value string) bool {
+		values = append(values, value)
+		return true
+	})
+	if !slices.Equal(values, []string{"d", "c", "b", "a"}) {
+		t.Fatalf("expected values in reverse order, got %v", values)
+	}
+
+	first := l.Front()
+	l.Reverse()
+	checkList(t, l, []string{"d", "c", "b", "a"})
+	if l.Back() != first || first.Value != "a" {
+		t.Fatal("expected the elements to remain valid after reversing")
+	}
+	l.PushFront("e")
+	checkList(t, l, []string{"e", "d", "c", "b", "a"})
+
+	empty := list.New[string]()
+	empty.Reverse()
+	checkList(t, empty, []string{})
+}
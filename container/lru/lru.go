@@ -0,0 +1,226 @@
+package lru
+
+import "fmt"
+
+type entry[K comparable, V any] struct {
+	key        K
+	value      V
+	cost       int
+	prev, next *entry[K, V]
+}
+
+// Cache implements a least recently used cache. Each entry has a cost, which is 1 by default,
+// and the least recently used entries are evicted whenever the total cost of the entries exceeds
+// the capacity of the cache. A Cache is not safe for concurrent use, see SyncCache for that.
+type Cache[K comparable, V any] struct {
+	items    map[K]*entry[K, V]
+	root     entry[K, V]
+	capacity int
+	cost     int
+	costFn   func(key K, value V) int
+	onEvict  func(key K, value V)
+}
+
+// New returns an empty cache which can hold up to 'capacity' entries. It panics if the capacity
+// is not positive.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	return NewWithCost(capacity, func(K, V) int { return 1 })
+}
+
+// NewWithCost returns an empty cache which evicts entries when the total cost of the entries, as
+// computed by the function 'cost', exceeds the capacity. The cost of an entry must be positive and
+// not exceed the capacity, otherwise the entry is rejected. It panics if the capacity is not
+// positive.
+func NewWithCost[K comparable, V any](capacity int, cost func(key K, value V) int) *Cache[K, V] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("lru: invalid capacity %d", capacity))
+	}
+	c := &Cache[K, V]{
+		items:    make(map[K]*entry[K, V]),
+		capacity: capacity,
+		costFn:   cost,
+	}
+	c.root.prev = &c.root
+	c.root.next = &c.root
+	return c
+}
+
+// OnEvict registers the function 'f' to be called for each entry evicted because the capacity of
+// the cache was exceeded. The function is not called for the entries removed explicitly.
+func (c *Cache[K, V]) OnEvict(f func(key K, value V)) {
+	c.onEvict = f
+}
+
+// Len returns the number of entries in the cache.
+func (c *Cache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Cost returns the total cost of the entries in the cache.
+func (c *Cache[K, V]) Cost() int {
+	return c.cost
+}
+
+// Capacity returns the maximum total cost of the entries in the cache.
+func (c *Cache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Get returns the value associated with the given key and marks the entry as the most recently
+// used one.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	e, found := c.items[key]
+	if !found {
+		var tmp V
+		return tmp, false
+	}
+	c.moveToFront(e)
+	return e.value, true
+}
+
+// Peek returns the value associated with the given key without marking the entry as used.
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	e, found := c.items[key]
+	if !found {
+		var tmp V
+		return tmp, false
+	}
+	return e.value, true
+}
+
+// Contains verifies if the given key is found in the cache without marking the entry as used.
+func (c *Cache[K, V]) Contains(key K) bool {
+	_, found := c.items[key]
+	return found
+}
+
+// Put adds a key-value pair to the cache, or replaces the value if the key is already present,
+// and marks the entry as the most recently used one. The least recently used entries are evicted
+// if the capacity is exceeded. An entry whose cost is not positive or exceeds the whole capacity
+// is rejected without evicting other entries: the eviction function is called for it and any
+// previous value of the key is removed.
+func (c *Cache[K, V]) Put(key K, value V) {
+	cost := c.costFn(key, value)
+	if cost <= 0 || cost > c.capacity {
+		if e, found := c.items[key]; found {
+			c.remove(e)
+		}
+		if c.onEvict != nil {
+			c.onEvict(key, value)
+		}
+		return
+	}
+	if e, found := c.items[key]; found {
+		c.cost += cost - e.cost
+		e.value = value
+		e.cost = cost
+		c.moveToFront(e)
+	} else {
+		e := &entry[K, V]{key: key, value: value, cost: cost}
+		c.items[key] = e
+		c.cost += cost
+		c.insertAfter(e, &c.root)
+	}
+	c.evict()
+}
+
+// Remove removes the given key from the cache and returns the value associated with it.
+func (c *Cache[K, V]) Remove(key K) (V, bool) {
+	e, found := c.items[key]
+	if !found {
+		var tmp V
+		return tmp, false
+	}
+	c.remove(e)
+	return e.value, true
+}
+
+// Oldest returns the least recently used entry without marking it as used.
+func (c *Cache[K, V]) Oldest() (K, V, bool) {
+	if len(c.items) == 0 {
+		var key K
+		var value V
+		return key, value, false
+	}
+	e := c.root.prev
+	return e.key, e.value, true
+}
+
+// RemoveOldest removes the least recently used entry from the cache and returns it, without
+// calling the eviction function.
+func (c *Cache[K, V]) RemoveOldest() (K, V, bool) {
+	key, value, ok := c.Oldest()
+	if ok {
+		c.remove(c.root.prev)
+	}
+	return key, value, ok
+}
+
+// Resize changes the capacity of the cache, evicting the least recently used entries if the new
+// capacity is exceeded. It returns the number of evicted entries and panics if the capacity is
+// not positive.
+func (c *Cache[K, V]) Resize(capacity int) int {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("lru: invalid capacity %d", capacity))
+	}
+	c.capacity = capacity
+	return c.evict()
+}
+
+// Keys returns the keys of the cache, from the most recently used to the least recently used.
+func (c *Cache[K, V]) Keys() []K {
+	keys := make([]K, 0, len(c.items))
+	for e := c.root.next; e != &c.root; e = e.next {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+// Clear removes all the entries from the cache without calling the eviction function.
+func (c *Cache[K, V]) Clear() {
+	for key := range c.items {
+		delete(c.items, key)
+	}
+	c.root.prev = &c.root
+	c.root.next = &c.root
+	c.cost = 0
+}
+
+// evict removes the least recently used entries until the total cost doesn't exceed the capacity
+// and returns the number of evicted entries.
+func (c *Cache[K, V]) evict() int {
+	evicted := 0
+	for c.cost > c.capacity {
+		e := c.root.prev
+		c.remove(e)
+		evicted++
+		if c.onEvict != nil {
+			c.onEvict(e.key, e.value)
+		}
+	}
+	return evicted
+}
+
+func (c *Cache[K, V]) remove(e *entry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev, e.next = nil, nil
+	delete(c.items, e.key)
+	c.cost -= e.cost
+}
+
+func (c *Cache[K, V]) moveToFront(e *entry[K, V]) {
+	if c.root.next == e {
+		return
+	}
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	c.insertAfter(e, &c.root)
+}
+
+func (c *Cache[K, V]) insertAfter(e, at *entry[K, V]) {
+	e.prev = at
+	e.next = at.next
+	at.next.prev = e
+	at.next = e
+}
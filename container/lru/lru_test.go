@@ -0,0 +1,134 @@
+package lru_test
+
+import (
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/lru"
+	"golang.org/x/exp/slices"
+)
+
+func TestLRUEviction(t *testing.T) {
+	c := lru.New[string, int](3)
+	evicted := make([]string, 0)
+	c.OnEvict(func(key string, value int) {
+		evicted = append(evicted, key)
+	})
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	if value, ok := c.Get("a"); !ok || value != 1 {
+		t.Fatalf("expected to get 1 for key a, got %d", value)
+	}
+	if value, ok := c.Peek("b"); !ok || value != 2 {
+		t.Fatalf("expected to peek 2 for key b, got %d", value)
+	}
+	c.Put("d", 4)
+
+	if !slices.Equal(evicted, []string{"b"}) {
+		t.Fatalf("expected b to be evicted, got %v", evicted)
+	}
+	if c.Contains("b") || c.Len() != 3 {
+		t.Fatalf("unexpected cache content %v", c.Keys())
+	}
+	if expected := []string{"d", "a", "c"}; !slices.Equal(c.Keys(), expected) {
+		t.Fatalf("expected keys %v, got %v", expected, c.Keys())
+	}
+
+	c.Put("c", 30)
+	if value, _ := c.Peek("c"); value != 30 || c.Len() != 3 {
+		t.Fatalf("expected to replace the value of c, got %d", value)
+	}
+	if value, ok := c.Remove("a"); !ok || value != 1 {
+		t.Fatalf("expected to remove 1 for key a, got %d", value)
+	}
+	if _, ok := c.Remove("a"); ok {
+		t.Fatal("didn't expected to remove a missing key")
+	}
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("didn't expected to get a removed key")
+	}
+
+	evicted = evicted[:0]
+	if n := c.Resize(1); n != 1 || c.Capacity() != 1 {
+		t.Fatalf("expected 1 entry to be evicted, got %d", n)
+	}
+	if !slices.Equal(evicted, []string{"d"}) || !slices.Equal(c.Keys(), []string{"c"}) {
+		t.Fatalf("expected d to be evicted, got %v", evicted)
+	}
+
+	c.Clear()
+	if c.Len() != 0 || c.Cost() != 0 || len(c.Keys()) != 0 {
+		t.Fatal("expected the cache to be empty")
+	}
+}
+
+func TestLRUOldest(t *testing.T) {
+	c := lru.New[int, string](3)
+	if _, _, ok := c.RemoveOldest(); ok {
+		t.Fatal("didn't expected to remove from an empty cache")
+	}
+	c.Put(1, "one")
+	c.Put(2, "two")
+	c.Put(3, "three")
+	c.Get(1)
+
+	if key, value, ok := c.Oldest(); !ok || key != 2 || value != "two" {
+		t.Fatalf("expected oldest entry 2, got %d", key)
+	}
+	if key, _, _ := c.RemoveOldest(); key != 2 || c.Contains(2) || c.Len() != 2 {
+		t.Fatalf("expected to remove oldest entry 2, got %d", key)
+	}
+	if key, _, _ := c.Oldest(); key != 3 {
+		t.Fatalf("expected oldest entry 3, got %d", key)
+	}
+}
+
+func TestLRUCost(t *testing.T) {
+	c := lru.NewWithCost(10, func(key string, value []byte) int {
+		return len(value)
+	})
+	c.Put("a", make([]byte, 4))
+	c.Put("b", make([]byte, 4))
+	if c.Cost() != 8 {
+		t.Fatalf("expected total cost 8, got %d", c.Cost())
+	}
+
+	c.Put("c", make([]byte, 3))
+	if c.Contains("a") || c.Cost() != 7 {
+		t.Fatalf("expected a to be evicted, got keys %v and cost %d", c.Keys(), c.Cost())
+	}
+	c.Put("b", make([]byte, 1))
+	if c.Cost() != 4 {
+		t.Fatalf("expected total cost 4 after replacing b, got %d", c.Cost())
+	}
+
+	evicted := make([]string, 0)
+	c.OnEvict(func(key string, value []byte) {
+		evicted = append(evicted, key)
+	})
+	c.Put("d", make([]byte, 11))
+	if c.Contains("d") || c.Len() != 2 || c.Cost() != 4 {
+		t.Fatalf("expected an entry exceeding the capacity to be rejected, got %v", c.Keys())
+	}
+	c.Put("e", nil)
+	if c.Contains("e") || c.Len() != 2 || c.Cost() != 4 {
+		t.Fatalf("expected an entry without cost to be rejected, got %v", c.Keys())
+	}
+	c.Put("b", make([]byte, 20))
+	if c.Contains("b") || c.Len() != 1 || c.Cost() != 3 {
+		t.Fatalf("expected the previous value of a rejected entry to be removed, got %v", c.Keys())
+	}
+	if expected := []string{"d", "e", "b"}; !slices.Equal(evicted, expected) {
+		t.Fatalf("expected only the rejected entries %v to be evicted, got %v", expected, evicted)
+	}
+}
+
+func TestInvalidCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected New to panic for a zero capacity")
+		}
+	}()
+	lru.New[int, int](0)
+}
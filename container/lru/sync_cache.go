@@ -0,0 +1,129 @@
+package lru
+
+import "sync"
+
+// SyncCache implements a least recently used cache which is safe for concurrent use by multiple
+// goroutines. The eviction function is called while the cache is locked, so it must not access
+// the cache.
+type SyncCache[K comparable, V any] struct {
+	mu    sync.Mutex
+	cache *Cache[K, V]
+}
+
+// NewSync returns an empty concurrency-safe cache which can hold up to 'capacity' entries.
+func NewSync[K comparable, V any](capacity int) *SyncCache[K, V] {
+	return &SyncCache[K, V]{
+		cache: New[K, V](capacity),
+	}
+}
+
+// NewSyncWithCost returns an empty concurrency-safe cache which evicts entries when the total
+// cost of the entries, as computed by the function 'cost', exceeds the capacity.
+func NewSyncWithCost[K comparable, V any](capacity int,
+	cost func(key K, value V) int) *SyncCache[K, V] {
+	return &SyncCache[K, V]{
+		cache: NewWithCost(capacity, cost),
+	}
+}
+
+// OnEvict registers the function 'f' to be called for each entry evicted because the capacity of
+// the cache was exceeded.
+func (c *SyncCache[K, V]) OnEvict(f func(key K, value V)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.OnEvict(f)
+}
+
+// Len returns the number of entries in the cache.
+func (c *SyncCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Len()
+}
+
+// Cost returns the total cost of the entries in the cache.
+func (c *SyncCache[K, V]) Cost() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Cost()
+}
+
+// Capacity returns the maximum total cost of the entries in the cache.
+func (c *SyncCache[K, V]) Capacity() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Capacity()
+}
+
+// Get returns the value associated with the given key and marks the entry as the most recently
+// used one.
+func (c *SyncCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Get(key)
+}
+
+// Peek returns the value associated with the given key without marking the entry as used.
+func (c *SyncCache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Peek(key)
+}
+
+// Contains verifies if the given key is found in the cache without marking the entry as used.
+func (c *SyncCache[K, V]) Contains(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Contains(key)
+}
+
+// Put adds a key-value pair to the cache, or replaces the value if the key is already present,
+// and marks the entry as the most recently used one. The entries having an invalid cost are
+// rejected, as described by Cache.Put.
+func (c *SyncCache[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Put(key, value)
+}
+
+// Remove removes the given key from the cache and returns the value associated with it.
+func (c *SyncCache[K, V]) Remove(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Remove(key)
+}
+
+// Oldest returns the least recently used entry without marking it as used.
+func (c *SyncCache[K, V]) Oldest() (K, V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Oldest()
+}
+
+// RemoveOldest removes the least recently used entry from the cache and returns it.
+func (c *SyncCache[K, V]) RemoveOldest() (K, V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.RemoveOldest()
+}
+
+// Resize changes the capacity of the cache and returns the number of evicted entries.
+func (c *SyncCache[K, V]) Resize(capacity int) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Resize(capacity)
+}
+
+// Keys returns the keys of the cache, from the most recently used to the least recently used.
+func (c *SyncCache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Keys()
+}
+
+// Clear removes all the entries from the cache without calling the eviction function.
+func (c *SyncCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Clear()
+}
@@ -0,0 +1,67 @@
+package lru_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/lru"
+)
+
+func TestSyncCacheConcurrentAccess(t *testing.T) {
+	c := lru.NewSync[int, int](100)
+	var evicted atomic.Int64
+	c.OnEvict(func(key, value int) {
+		evicted.Add(1)
+	})
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				key := w*1000 + i
+				c.Put(key, i)
+				if value, ok := c.Get(key); ok && value != i {
+					t.Errorf("expected value %d for key %d, got %d", i, key, value)
+				}
+				c.Peek(i)
+				c.Contains(i)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if c.Len() != 100 || c.Cost() != 100 || c.Capacity() != 100 || len(c.Keys()) != 100 {
+		t.Fatalf("expected a full cache with 100 entries, got %d", c.Len())
+	}
+	if evicted.Load() != 3900 {
+		t.Fatalf("expected 3900 evicted entries, got %d", evicted.Load())
+	}
+
+	c.Resize(10)
+	if key, _, ok := c.Oldest(); !ok || !c.Contains(key) {
+		t.Fatalf("expected oldest key %d to be found in the cache", key)
+	}
+	if key, _, ok := c.RemoveOldest(); !ok || c.Contains(key) {
+		t.Fatalf("didn't expected removed key %d to be found in the cache", key)
+	}
+	c.Put(-1, -1)
+	if _, ok := c.Remove(c.Keys()[0]); !ok || c.Len() != 9 {
+		t.Fatalf("expected 9 entries after resize and removal, got %d", c.Len())
+	}
+	c.Clear()
+	if c.Len() != 0 {
+		t.Fatal("expected the cache to be empty")
+	}
+
+	weighted := lru.NewSyncWithCost(10, func(key string, value string) int {
+		return len(value)
+	})
+	weighted.Put("a", "12345")
+	weighted.Put("b", "123456")
+	if weighted.Contains("a") || weighted.Cost() != 6 {
+		t.Fatalf("expected a to be evicted, got keys %v", weighted.Keys())
+	}
+}
@@ -0,0 +1,215 @@
+package multiset
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/playgroundgo/genlib/container/set"
+	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/slices"
+)
+
+// Multiset implements a multiset container, also known as a bag, which keeps the number of
+// occurrences of each element using the standard library map container.
+type Multiset[T comparable] map[T]int
+
+// New creates a new multiset.
+func New[T comparable]() Multiset[T] {
+	return make(Multiset[T])
+}
+
+// NewWithInitialSpace returns a new multiset having enough space to hold 'n' distinct elements.
+func NewWithInitialSpace[T comparable](n int) Multiset[T] {
+	return make(Multiset[T], n)
+}
+
+// Size returns the number of elements in the multiset, including duplicates.
+func (m Multiset[T]) Size() int {
+	size := 0
+	for _, count := range m {
+		size += count
+	}
+	return size
+}
+
+// Distinct returns the number of distinct elements in the multiset.
+func (m Multiset[T]) Distinct() int {
+	return len(m)
+}
+
+// IsEmpty returns 'true' if the multiset is empty.
+func (m Multiset[T]) IsEmpty() bool {
+	return len(m) == 0
+}
+
+// Equal checks if this multiset is equal with another one.
+func (m Multiset[T]) Equal(other Multiset[T]) bool {
+	if len(m) != len(other) {
+		return false
+	}
+	for elem, count := range m {
+		if other[elem] != count {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns a string representation of the multiset, listing each element with its count.
+func (m Multiset[T]) String() string {
+	entries := make([]string, 0, len(m))
+	for elem, count := range m {
+		entries = append(entries, fmt.Sprintf("%v: %d", elem, count))
+	}
+	slices.Sort(entries)
+	return "{" + strings.Join(entries, ", ") + "}"
+}
+
+// Clear clears the content of the multiset.
+func (m Multiset[T]) Clear() {
+	for elem := range m {
+		delete(m, elem)
+	}
+}
+
+// Clone returns a copy of the given multiset.
+func (m Multiset[T]) Clone() Multiset[T] {
+	cloned := NewWithInitialSpace[T](len(m))
+	for elem, count := range m {
+		cloned[elem] = count
+	}
+	return cloned
+}
+
+// Contains verifies if an element belongs to the multiset.
+func (m Multiset[T]) Contains(elem T) bool {
+	_, found := m[elem]
+	return found
+}
+
+// Count returns the number of occurrences of an element in the multiset.
+func (m Multiset[T]) Count(elem T) int {
+	return m[elem]
+}
+
+// ForEach calls the 'f' function for each distinct element in the multiset, together with its
+// count, while the function returns true.
+func (m Multiset[T]) ForEach(f func(elem T, count int) bool) {
+	for elem, count := range m {
+		if !f(elem, count) {
+			break
+		}
+	}
+}
+
+// Add adds 'n' occurrences of an element to the multiset. Non-positive values of 'n' are ignored.
+func (m Multiset[T]) Add(elem T, n int) {
+	if n > 0 {
+		m[elem] += n
+	}
+}
+
+// AddAll adds one occurrence of each of the specified elements to the multiset.
+func (m Multiset[T]) AddAll(elems ...T) {
+	for _, elem := range elems {
+		m.Add(elem, 1)
+	}
+}
+
+// Remove removes up to 'n' occurrences of an element from the multiset. Non-positive values of
+// 'n' are ignored.
+func (m Multiset[T]) Remove(elem T, n int) {
+	if n <= 0 {
+		return
+	}
+	if count := m[elem] - n; count > 0 {
+		m[elem] = count
+	} else {
+		delete(m, elem)
+	}
+}
+
+// RemoveAll removes all the occurrences of the specified elements from the multiset.
+func (m Multiset[T]) RemoveAll(elems ...T) {
+	for _, elem := range elems {
+		delete(m, elem)
+	}
+}
+
+// MostCommon returns up to 'k' distinct elements having the highest counts, together with their
+// counts, in descending order of the counts.
+func (m Multiset[T]) MostCommon(k int) []generic.Pair[T, int] {
+	entries := make([]generic.Pair[T, int], 0, len(m))
+	for elem, count := range m {
+		entries = append(entries, generic.Pair[T, int]{First: elem, Second: count})
+	}
+	slices.SortFunc(entries, func(a, b generic.Pair[T, int]) bool {
+		return a.Second > b.Second
+	})
+	if k < 0 {
+		k = 0
+	}
+	return entries[:generic.Min(k, len(entries))]
+}
+
+// Union returns a multiset where the count of each element is the largest of its counts in this
+// multiset and another one.
+func (m Multiset[T]) Union(other Multiset[T]) Multiset[T] {
+	result := m.Clone()
+	for elem, count := range other {
+		result[elem] = generic.Max(result[elem], count)
+	}
+	return result
+}
+
+// Intersect returns a multiset where the count of each element is the smallest of its counts in
+// this multiset and another one.
+func (m Multiset[T]) Intersect(other Multiset[T]) Multiset[T] {
+	result := New[T]()
+	for elem, count := range m {
+		if otherCount, found := other[elem]; found {
+			result[elem] = generic.Min(count, otherCount)
+		}
+	}
+	return result
+}
+
+// Sum returns a multiset where the count of each element is the sum of its counts in this
+// multiset and another one.
+func (m Multiset[T]) Sum(other Multiset[T]) Multiset[T] {
+	result := m.Clone()
+	for elem, count := range other {
+		result[elem] += count
+	}
+	return result
+}
+
+// Difference returns a multiset where the count of each element is its count in this multiset
+// minus its count in another one, keeping only the elements with positive counts.
+func (m Multiset[T]) Difference(other Multiset[T]) Multiset[T] {
+	result := m.Clone()
+	for elem, count := range other {
+		result.Remove(elem, count)
+	}
+	return result
+}
+
+// ToSet returns a set containing the distinct elements of the multiset.
+func (m Multiset[T]) ToSet() set.Set[T] {
+	result := set.NewWithInitialSpace[T](len(m))
+	for elem := range m {
+		result.Add(elem)
+	}
+	return result
+}
+
+// ToSlice returns a slice containing all the elements from the multiset, including duplicates.
+func (m Multiset[T]) ToSlice() []T {
+	elems := make([]T, 0, m.Size())
+	for elem, count := range m {
+		for i := 0; i < count; i++ {
+			elems = append(elems, elem)
+		}
+	}
+	return elems
+}
@@ -0,0 +1,102 @@
+package multiset_test
+
+import (
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/multiset"
+	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/slices"
+)
+
+func TestBasicMultisetOperations(t *testing.T) {
+	m := multiset.New[string]()
+	m.Add("a", 3)
+	m.Add("b", 1)
+	m.Add("c", 0)
+	m.AddAll("b", "c")
+
+	if m.Count("a") != 3 || m.Count("b") != 2 || m.Count("c") != 1 || m.Count("d") != 0 {
+		t.Fatalf("unexpected multiset content %v", m)
+	}
+	if m.Size() != 6 || m.Distinct() != 3 {
+		t.Fatalf("expected 6 elements and 3 distinct elements, got %d and %d", m.Size(),
+			m.Distinct())
+	}
+	if str := m.String(); str != "{a: 3, b: 2, c: 1}" {
+		t.Fatalf("unexpected string representation %s", str)
+	}
+
+	clone := m.Clone()
+	m.Remove("a", 2)
+	m.Remove("b", 5)
+	m.Remove("c", -1)
+	if m.Count("a") != 1 || m.Contains("b") || m.Count("c") != 1 {
+		t.Fatalf("unexpected multiset content after removal %v", m)
+	}
+	if clone.Equal(m) || clone.Count("a") != 3 {
+		t.Fatal("didn't expected the clone to be modified")
+	}
+
+	items := clone.ToSlice()
+	slices.Sort(items)
+	if expected := []string{"a", "a", "a", "b", "b", "c"}; !slices.Equal(items, expected) {
+		t.Fatalf("expected %v, got %v", expected, items)
+	}
+	if s := clone.ToSet(); s.Size() != 3 || !s.ContainsAll("a", "b", "c") {
+		t.Fatalf("unexpected set of distinct elements %v", s)
+	}
+
+	m.RemoveAll("a", "c")
+	if !m.IsEmpty() {
+		t.Fatal("expected the multiset to be empty")
+	}
+	clone.Clear()
+	if !clone.IsEmpty() {
+		t.Fatal("expected the clone to be empty")
+	}
+}
+
+func TestMostCommon(t *testing.T) {
+	m := multiset.New[rune]()
+	m.AddAll([]rune("abracadabra")...)
+
+	expected := []generic.Pair[rune, int]{{First: 'a', Second: 5}, {First: 'b', Second: 2}}
+	mostCommon := m.MostCommon(1)
+	if !slices.Equal(mostCommon, expected[:1]) {
+		t.Fatalf("expected %v, got %v", expected[:1], mostCommon)
+	}
+	if all := m.MostCommon(10); len(all) != 5 || all[0] != expected[0] {
+		t.Fatalf("expected all the 5 distinct elements, got %v", all)
+	}
+	if none := m.MostCommon(-1); len(none) != 0 {
+		t.Fatalf("expected no elements, got %v", none)
+	}
+}
+
+func TestMultisetAlgebra(t *testing.T) {
+	m1 := multiset.New[int]()
+	m2 := multiset.New[int]()
+	m1.Add(1, 3)
+	m1.Add(2, 1)
+	m2.Add(1, 1)
+	m2.Add(3, 2)
+
+	build := func(counts map[int]int) multiset.Multiset[int] {
+		return multiset.Multiset[int](counts)
+	}
+	tests := []struct {
+		name     string
+		result   multiset.Multiset[int]
+		expected multiset.Multiset[int]
+	}{
+		{"union", m1.Union(m2), build(map[int]int{1: 3, 2: 1, 3: 2})},
+		{"intersection", m1.Intersect(m2), build(map[int]int{1: 1})},
+		{"sum", m1.Sum(m2), build(map[int]int{1: 4, 2: 1, 3: 2})},
+		{"difference", m1.Difference(m2), build(map[int]int{1: 2, 2: 1})},
+	}
+	for _, test := range tests {
+		if !test.result.Equal(test.expected) {
+			t.Fatalf("expected %s %v, got %v", test.name, test.expected, test.result)
+		}
+	}
+}
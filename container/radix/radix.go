@@ -0,0 +1,229 @@
+package radix
+
+import (
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+type node[V any] struct {
+	// prefix is the part of the key consumed by the edge leading to the node.
+	prefix string
+	// key is the full key of the node, set only if the node holds a value.
+	key      string
+	value    V
+	hasValue bool
+	// children are sorted by the first byte of their prefix, which is distinct for each child.
+	children []*node[V]
+}
+
+// child returns the index of the child whose prefix starts with the given byte, or the index where
+// such a child should be inserted.
+func (n *node[V]) child(label byte) (int, bool) {
+	return slices.BinarySearchFunc(n.children, label, func(child *node[V], label byte) int {
+		return int(child.prefix[0]) - int(label)
+	})
+}
+
+func (n *node[V]) setValue(key string, value V) {
+	n.key, n.value, n.hasValue = key, value, true
+}
+
+// mergeChild merges the node with its only child, when the node holds no value.
+func (n *node[V]) mergeChild() {
+	child := n.children[0]
+	n.prefix += child.prefix
+	n.key, n.value, n.hasValue = child.key, child.value, child.hasValue
+	n.children = child.children
+}
+
+// Tree implements a radix tree, which maps string keys to values and supports efficient prefix
+// queries. The chains of nodes having a single child are compressed into a single edge. Byte slice
+// keys can be used after converting them to strings. The zero value is an empty tree ready to use.
+type Tree[V any] struct {
+	root node[V]
+	size int
+}
+
+// New returns an empty radix tree.
+func New[V any]() *Tree[V] {
+	return &Tree[V]{}
+}
+
+// Len returns the number of keys in the tree.
+func (t *Tree[V]) Len() int {
+	return t.size
+}
+
+// IsEmpty returns 'true' if the tree has no keys.
+func (t *Tree[V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Insert associates the given value with the key, replacing the previous value if the key is
+// already present. It returns 'true' if the key was inserted.
+func (t *Tree[V]) Insert(key string, value V) bool {
+	n, search := &t.root, key
+	for {
+		if search == "" {
+			inserted := !n.hasValue
+			n.setValue(key, value)
+			if inserted {
+				t.size++
+			}
+			return inserted
+		}
+
+		i, found := n.child(search[0])
+		if !found {
+			leaf := &node[V]{prefix: search}
+			leaf.setValue(key, value)
+			n.children = slices.Insert(n.children, i, leaf)
+			t.size++
+			return true
+		}
+		child := n.children[i]
+		common := commonPrefix(search, child.prefix)
+		if common == len(child.prefix) {
+			n, search = child, search[common:]
+			continue
+		}
+
+		// The key diverges inside the prefix of the child, so split the edge at that point.
+		split := &node[V]{prefix: search[:common], children: []*node[V]{child}}
+		child.prefix = child.prefix[common:]
+		n.children[i] = split
+		n, search = split, search[common:]
+	}
+}
+
+// Get returns the value associated with the given key.
+func (t *Tree[V]) Get(key string) (V, bool) {
+	n, _, _ := t.find(key)
+	if n == nil || !n.hasValue {
+		var tmp V
+		return tmp, false
+	}
+	return n.value, true
+}
+
+// Delete removes the given key from the tree and returns the value associated with it.
+func (t *Tree[V]) Delete(key string) (V, bool) {
+	n, parent, i := t.find(key)
+	if n == nil || !n.hasValue {
+		var tmp V
+		return tmp, false
+	}
+	value := n.value
+	var zero V
+	n.key, n.value, n.hasValue = "", zero, false
+	t.size--
+
+	// Remove the nodes left without a value or children, and compress the chains left behind.
+	if n == &t.root {
+		return value, true
+	}
+	switch len(n.children) {
+	case 0:
+		parent.children = slices.Delete(parent.children, i, i+1)
+		if parent != &t.root && !parent.hasValue && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case 1:
+		n.mergeChild()
+	}
+	return value, true
+}
+
+// LongestPrefix returns the longest key of the tree which is a prefix of the given key, and the
+// value associated with it.
+func (t *Tree[V]) LongestPrefix(key string) (string, V, bool) {
+	var longest *node[V]
+	n, search := &t.root, key
+	for {
+		if n.hasValue {
+			longest = n
+		}
+		if search == "" {
+			break
+		}
+		i, found := n.child(search[0])
+		if !found || !strings.HasPrefix(search, n.children[i].prefix) {
+			break
+		}
+		n = n.children[i]
+		search = search[len(n.prefix):]
+	}
+	if longest == nil {
+		var tmp V
+		return "", tmp, false
+	}
+	return longest.key, longest.value, true
+}
+
+// WalkPrefix calls the 'f' function for each key starting with the given prefix, in lexicographic
+// order, while the function returns true.
+func (t *Tree[V]) WalkPrefix(prefix string, f func(key string, value V) bool) {
+	n, search := &t.root, prefix
+	for search != "" {
+		i, found := n.child(search[0])
+		if !found {
+			return
+		}
+		child := n.children[i]
+		if strings.HasPrefix(child.prefix, search) {
+			// All the keys below the child start with the prefix.
+			n = child
+			break
+		}
+		if !strings.HasPrefix(search, child.prefix) {
+			return
+		}
+		n, search = child, search[len(child.prefix):]
+	}
+	walk(n, f)
+}
+
+// Walk calls the 'f' function for each key of the tree, in lexicographic order, while the
+// function returns true.
+func (t *Tree[V]) Walk(f func(key string, value V) bool) {
+	walk(&t.root, f)
+}
+
+// find returns the node reached by consuming the whole key, its parent and its index among the
+// children of the parent. The node is nil if the key ends inside an edge or isn't found.
+func (t *Tree[V]) find(key string) (*node[V], *node[V], int) {
+	var parent *node[V]
+	index := 0
+	n, search := &t.root, key
+	for search != "" {
+		i, found := n.child(search[0])
+		if !found || !strings.HasPrefix(search, n.children[i].prefix) {
+			return nil, nil, 0
+		}
+		parent, index = n, i
+		n = n.children[i]
+		search = search[len(n.prefix):]
+	}
+	return n, parent, index
+}
+
+func walk[V any](n *node[V], f func(key string, value V) bool) bool {
+	if n.hasValue && !f(n.key, n.value) {
+		return false
+	}
+	for _, child := range n.children {
+		if !walk(child, f) {
+			return false
+		}
+	}
+	return true
+}
+
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
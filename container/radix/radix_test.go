@@ -0,0 +1,155 @@
+package radix_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/radix"
+	"golang.org/x/exp/slices"
+)
+
+func walkPrefix(t *radix.Tree[int], prefix string) []string {
+	keys := make([]string, 0)
+	t.WalkPrefix(prefix, func(key string, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func TestTree(t *testing.T) {
+	var tree radix.Tree[int]
+	for i, key := range []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon",
+		"rubicundus", "rom", ""} {
+		if !tree.Insert(key, i) {
+			t.Fatalf("expected to insert key %q", key)
+		}
+	}
+	if tree.Insert("ruber", 40) || tree.Len() != 9 {
+		t.Fatal("expected to replace the value of ruber")
+	}
+	if value, ok := tree.Get("ruber"); !ok || value != 40 {
+		t.Fatalf("expected to get 40 for key ruber, got %d", value)
+	}
+	for _, key := range []string{"r", "ro", "roman", "rubicons", "x"} {
+		if _, ok := tree.Get(key); ok {
+			t.Fatalf("didn't expected to get key %q", key)
+		}
+	}
+
+	if expected := []string{"rubens", "ruber", "rubicon", "rubicundus"}; !slices.Equal(
+		walkPrefix(&tree, "rub"), expected) {
+		t.Fatalf("expected keys %v, got %v", expected, walkPrefix(&tree, "rub"))
+	}
+	if expected := []string{"rubicon", "rubicundus"}; !slices.Equal(walkPrefix(&tree, "rubic"),
+		expected) {
+		t.Fatalf("expected keys %v, got %v", expected, walkPrefix(&tree, "rubic"))
+	}
+	if keys := walkPrefix(&tree, "romx"); len(keys) != 0 {
+		t.Fatalf("didn't expected any keys, got %v", keys)
+	}
+	if keys := walkPrefix(&tree, ""); len(keys) != 9 || keys[0] != "" || keys[1] != "rom" {
+		t.Fatalf("expected all the keys in order, got %v", keys)
+	}
+	visited := 0
+	tree.Walk(func(key string, _ int) bool {
+		visited++
+		return key != "romane"
+	})
+	if visited != 3 {
+		t.Fatalf("expected to stop after 3 keys, visited %d", visited)
+	}
+
+	if key, value, ok := tree.LongestPrefix("romanesque"); !ok || key != "romane" || value != 0 {
+		t.Fatalf("expected longest prefix romane, got %q", key)
+	}
+	if key, _, ok := tree.LongestPrefix("romanum"); !ok || key != "rom" {
+		t.Fatalf("expected longest prefix rom, got %q", key)
+	}
+	if key, _, ok := tree.LongestPrefix("x"); !ok || key != "" {
+		t.Fatalf("expected the empty key as longest prefix, got %q", key)
+	}
+
+	if value, ok := tree.Delete("rom"); !ok || value != 7 {
+		t.Fatalf("expected to delete 7 for key rom, got %d", value)
+	}
+	if _, ok := tree.Delete("rom"); ok {
+		t.Fatal("didn't expected to delete a missing key")
+	}
+	if _, ok := tree.Delete("ro"); ok {
+		t.Fatal("didn't expected to delete a key ending inside an edge")
+	}
+	tree.Delete("")
+	if _, _, ok := tree.LongestPrefix("romanum"); ok || tree.Len() != 7 {
+		t.Fatal("didn't expected a longest prefix after the deletions")
+	}
+	for _, key := range walkPrefix(&tree, "") {
+		tree.Delete(key)
+	}
+	if !tree.IsEmpty() || len(walkPrefix(&tree, "")) != 0 {
+		t.Fatal("expected the tree to be empty")
+	}
+}
+
+func TestTreeRandomized(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	randomKey := func() string {
+		b := make([]byte, rnd.Intn(6))
+		for i := range b {
+			b[i] = "abc"[rnd.Intn(3)]
+		}
+		return string(b)
+	}
+
+	tree := radix.New[int]()
+	model := make(map[string]int)
+	for i := 0; i < 20000; i++ {
+		key := randomKey()
+		switch rnd.Intn(3) {
+		case 0:
+			_, found := model[key]
+			if tree.Insert(key, i) == found {
+				t.Fatalf("expected insertion of key %q to be %v", key, !found)
+			}
+			model[key] = i
+		case 1:
+			expected, found := model[key]
+			if value, ok := tree.Delete(key); ok != found || value != expected {
+				t.Fatalf("expected to delete %d for key %q, got %d", expected, key, value)
+			}
+			delete(model, key)
+		default:
+			expected, found := model[key]
+			if value, ok := tree.Get(key); ok != found || value != expected {
+				t.Fatalf("expected to get %d for key %q, got %d", expected, key, value)
+			}
+		}
+		if tree.Len() != len(model) {
+			t.Fatalf("expected %d keys, got %d", len(model), tree.Len())
+		}
+		if i%100 != 0 {
+			continue
+		}
+
+		prefix := randomKey()
+		expected := make([]string, 0)
+		longest, hasLongest := "", false
+		for key := range model {
+			if strings.HasPrefix(key, prefix) {
+				expected = append(expected, key)
+			}
+			if strings.HasPrefix(prefix, key) && (!hasLongest || len(key) > len(longest)) {
+				longest, hasLongest = key, true
+			}
+		}
+		slices.Sort(expected)
+		if keys := walkPrefix(tree, prefix); !slices.Equal(keys, expected) {
+			t.Fatalf("expected keys %v with prefix %q, got %v", expected, prefix, keys)
+		}
+		if key, value, ok := tree.LongestPrefix(prefix); ok != hasLongest || key != longest ||
+			(ok && value != model[key]) {
+			t.Fatalf("expected longest prefix %q of %q, got %q", longest, prefix, key)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package redblack
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/playgroundgo/genlib/generic"
+)
+
+// FromSorted builds a balanced tree from the given keys and values in linear time. The keys must
+// be sorted in strictly ascending order and each key is associated with the value found at the
+// same index.
+func FromSorted[K, V any](less generic.LessFn[K], keys []K, values []V) (*Tree[K, V], error) {
+	if len(keys) != len(values) {
+		return nil, fmt.Errorf("got %d keys and %d values", len(keys), len(values))
+	}
+	for i := 1; i < len(keys); i++ {
+		if !less(keys[i-1], keys[i]) {
+			return nil, fmt.Errorf("keys are not sorted in strictly ascending order at index %d", i)
+		}
+	}
+
+	t := New[K, V](less)
+	// All the nil links are found either below the deepest level or right above it, so coloring
+	// the deepest level red keeps the black height equal across all paths.
+	t.root = buildSorted(keys, values, 0, bits.Len(uint(len(keys)))-1)
+	if t.root != nil {
+		t.root.color = black
+	}
+	t.size = uint32(len(keys))
+	return t, nil
+}
+
+// Split moves all the elements of the tree into two new trees, the first one holding the keys
+// less than the given key and the second one holding the rest of the keys. The tree is empty
+// after the call.
+func (t *Tree[K, V]) Split(key K) (*Tree[K, V], *Tree[K, V]) {
+	l, r := t.split(t.root, key)
+	t.root = nil
+	t.size = 0
+	return t.withRoot(l), t.withRoot(r)
+}
+
+// Join moves all the elements of the other tree into this one. The key ranges of the two trees
+// must not overlap, so all the keys of the other tree must be either greater or less than the keys
+// of this tree. The other tree is empty after the call.
+func (t *Tree[K, V]) Join(other *Tree[K, V]) error {
+	if t.root == nil || other.root == nil {
+		if t.root == nil {
+			t.root, t.size = other.root, other.size
+		}
+		other.root, other.size = nil, 0
+		return nil
+	}
+	first, last := t.root.extreme(left), t.root.extreme(right)
+	otherFirst, otherLast := other.root.extreme(left), other.root.extreme(right)
+	switch {
+	case t.less(last.key, otherFirst.key):
+		other.deleteNode(otherFirst)
+		t.root = t.link(t.root, otherFirst, other.root)
+	case t.less(otherLast.key, first.key):
+		other.deleteNode(otherLast)
+		t.root = t.link(other.root, otherLast, t.root)
+	default:
+		return fmt.Errorf("key range [%v, %v] of the joined tree overlaps with [%v, %v]",
+			otherFirst.key, otherLast.key, first.key, last.key)
+	}
+	t.size = t.root.size
+	other.root, other.size = nil, 0
+	return nil
+}
+
+func buildSorted[K, V any](keys []K, values []V, depth, redDepth int) *node[K, V] {
+	if len(keys) == 0 {
+		return nil
+	}
+	mid := len(keys) / 2
+	n := &node[K, V]{key: keys[mid], value: values[mid], color: black}
+	if depth == redDepth {
+		n.color = red
+	}
+	n.setChildren(
+		buildSorted(keys[:mid], values[:mid], depth+1, redDepth),
+		buildSorted(keys[mid+1:], values[mid+1:], depth+1, redDepth),
+	)
+	return n
+}
+
+func (t *Tree[K, V]) withRoot(root *node[K, V]) *Tree[K, V] {
+	return &Tree[K, V]{
+		root: root,
+		less: t.less,
+		size: root.subtreeSize(),
+	}
+}
+
+// split splits the subtree rooted at 'n' into two subtrees, holding the keys less than the given
+// key and the rest of the keys.
+func (t *Tree[K, V]) split(n *node[K, V], key K) (*node[K, V], *node[K, V]) {
+	if n == nil {
+		return nil, nil
+	}
+	if t.less(n.key, key) {
+		l, r := t.split(n.children[right], key)
+		return t.link(n.children[left], n, l), r
+	}
+	l, r := t.split(n.children[left], key)
+	return l, t.link(r, n, n.children[right])
+}
+
+// link builds a valid red-black tree from the subtrees 'l' and 'r' and the 'pivot' node, where all
+// the keys of 'l' are less than the pivot key and all the keys of 'r' are greater than it. The
+// root of the new tree is returned.
+func (t *Tree[K, V]) link(l, pivot, r *node[K, V]) *node[K, V] {
+	l, r = detach(l), detach(r)
+	leftHeight, rightHeight := blackHeight(l), blackHeight(r)
+	pivot.parent = nil
+	pivot.color = red
+
+	if leftHeight == rightHeight {
+		pivot.color = black
+		pivot.setChildren(l, r)
+		return pivot
+	}
+
+	// Walk down the inner spine of the taller tree until reaching a black node having the same
+	// black height as the shorter tree and put the pivot in its place.
+	tall, short, dir, height, target := l, r, right, leftHeight, rightHeight
+	if rightHeight > leftHeight {
+		tall, short, dir, height, target = r, l, left, rightHeight, leftHeight
+	}
+	var parent *node[K, V]
+	n := tall
+	for n != nil && (n.color != black || height != target) {
+		if n.color == black {
+			height--
+		}
+		parent = n
+		n = n.children[dir]
+	}
+
+	if dir == right {
+		pivot.setChildren(n, short)
+	} else {
+		pivot.setChildren(short, n)
+	}
+	pivot.parent = parent
+	parent.children[dir] = pivot
+	for p := parent; p != nil; p = p.parent {
+		p.size += short.subtreeSize() + 1
+	}
+
+	linked := &Tree[K, V]{root: tall, less: t.less}
+	linked.insertFixup(pivot)
+	return linked.root
+}
+
+func (n *node[K, V]) setChildren(l, r *node[K, V]) {
+	n.children = [2]*node[K, V]{l, r}
+	for _, child := range n.children {
+		if child != nil {
+			child.parent = n
+		}
+	}
+	n.size = l.subtreeSize() + r.subtreeSize() + 1
+}
+
+// detach turns the subtree rooted at 'n' into a standalone red-black tree.
+func detach[K, V any](n *node[K, V]) *node[K, V] {
+	if n != nil {
+		n.parent = nil
+		n.color = black
+	}
+	return n
+}
+
+// blackHeight returns the number of black nodes found on any path from 'n' to a leaf.
+func blackHeight[K, V any](n *node[K, V]) int {
+	height := 0
+	for ; n != nil; n = n.children[left] {
+		if n.color == black {
+			height++
+		}
+	}
+	return height
+}
@@ -0,0 +1,133 @@
+package redblack_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/redblack"
+	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/slices"
+)
+
+func TestFromSorted(t *testing.T) {
+	for n := 0; n <= 130; n++ {
+		keys := make([]int, n)
+		values := make([]string, n)
+		for i := range keys {
+			keys[i] = i * 2
+			values[i] = string(rune('a' + i%26))
+		}
+		tree, err := redblack.FromSorted(generic.Less[int], keys, values)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("invalid tree built from %d keys: %v", n, err)
+		}
+		if int(tree.Size()) != n {
+			t.Fatalf("expected %d elements in the tree, got %d", n, tree.Size())
+		}
+		i := 0
+		tree.Ascend(func(key int, value string) bool {
+			if key != keys[i] || value != values[i] {
+				t.Fatalf("unexpected element (%d, %s) at position %d", key, value, i)
+			}
+			i++
+			return true
+		})
+		tree.Insert(1, "x")
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("invalid tree after insertion: %v", err)
+		}
+	}
+
+	if _, err := redblack.FromSorted(generic.Less[int], []int{1, 2}, []int{1}); err == nil {
+		t.Fatal("expected an error for mismatched keys and values")
+	}
+	if _, err := redblack.FromSorted(generic.Less[int], []int{1, 1}, []int{1, 2}); err == nil {
+		t.Fatal("expected an error for duplicate keys")
+	}
+	if _, err := redblack.FromSorted(generic.Less[int], []int{2, 1}, []int{1, 2}); err == nil {
+		t.Fatal("expected an error for unsorted keys")
+	}
+}
+
+func TestSplitJoin(t *testing.T) {
+	rnd := rand.New(rand.NewSource(11))
+	for round := 0; round < 200; round++ {
+		tree := redblack.New[int, int](generic.Less[int])
+		keys := make([]int, 0)
+		for i := rnd.Intn(300); i > 0; i-- {
+			key := rnd.Intn(1000)
+			if tree.Insert(key, -key) {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		pivot := rnd.Intn(1100) - 50
+		lower, upper := tree.Split(pivot)
+		if !tree.IsEmpty() {
+			t.Fatal("expected the split tree to be empty")
+		}
+		i, _ := slices.BinarySearch(keys, pivot)
+		checkTree(t, lower, keys[:i])
+		checkTree(t, upper, keys[i:])
+
+		joined, other := lower, upper
+		if round%2 == 1 {
+			joined, other = upper, lower
+		}
+		if err := joined.Join(other); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !other.IsEmpty() {
+			t.Fatal("expected the joined tree to be empty")
+		}
+		checkTree(t, joined, keys)
+	}
+}
+
+func TestJoinOverlapping(t *testing.T) {
+	newTree := func(keys ...int) *redblack.Tree[int, int] {
+		tree := redblack.New[int, int](generic.Less[int])
+		for _, key := range keys {
+			tree.Insert(key, -key)
+		}
+		return tree
+	}
+
+	tree := newTree(10)
+	if err := tree.Join(newTree(1)); err != nil {
+		t.Fatalf("unexpected error when joining a lower tree: %v", err)
+	}
+	checkTree(t, tree, []int{1, 10})
+
+	for _, keys := range [][]int{{5}, {0, 5}, {5, 20}, {0, 20}, {10}} {
+		other := newTree(keys...)
+		if err := tree.Join(other); err == nil {
+			t.Fatalf("expected an error when joining overlapping keys %v", keys)
+		}
+		checkTree(t, tree, []int{1, 10})
+		checkTree(t, other, keys)
+	}
+}
+
+func checkTree(t *testing.T, tree *redblack.Tree[int, int], keys []int) {
+	t.Helper()
+
+	if err := tree.Validate(); err != nil {
+		t.Fatalf("invalid tree: %v", err)
+	}
+	collected := make([]int, 0, len(keys))
+	tree.Ascend(func(key, value int) bool {
+		if value != -key {
+			t.Fatalf("unexpected value %d for key %d", value, key)
+		}
+		collected = append(collected, key)
+		return true
+	})
+	if !slices.Equal(collected, keys) {
+		t.Fatalf("expected keys %v, got %v", keys, collected)
+	}
+}
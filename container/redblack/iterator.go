@@ -0,0 +1,76 @@
+package redblack
+
+type iteratorPosition uint8
+
+const (
+	beforeFirst iteratorPosition = iota
+	atNode
+	afterLast
+)
+
+// Iterator is a stateful cursor over the elements of a tree in key order. An iterator remains
+// valid as long as the tree is not modified.
+type Iterator[K, V any] struct {
+	tree     *Tree[K, V]
+	node     *node[K, V]
+	position iteratorPosition
+}
+
+// Iterator returns a new iterator positioned before the first element of the tree.
+func (t *Tree[K, V]) Iterator() *Iterator[K, V] {
+	return &Iterator[K, V]{
+		tree: t,
+	}
+}
+
+// Next moves the iterator to the next element and returns 'true' if such an element exists.
+func (it *Iterator[K, V]) Next() bool {
+	switch it.position {
+	case beforeFirst:
+		it.moveTo(it.tree.root.extreme(left), afterLast)
+	case atNode:
+		it.moveTo(it.node.step(right), afterLast)
+	}
+	return it.position == atNode
+}
+
+// Prev moves the iterator to the previous element and returns 'true' if such an element exists.
+func (it *Iterator[K, V]) Prev() bool {
+	switch it.position {
+	case afterLast:
+		it.moveTo(it.tree.root.extreme(right), beforeFirst)
+	case atNode:
+		it.moveTo(it.node.step(left), beforeFirst)
+	}
+	return it.position == atNode
+}
+
+// Seek moves the iterator to the first element having the key greater than or equal to the given
+// key and returns 'true' if such an element exists.
+func (it *Iterator[K, V]) Seek(key K) bool {
+	it.moveTo(it.tree.nearest(key, right, true), afterLast)
+	return it.position == atNode
+}
+
+// Valid returns 'true' if the iterator is positioned on an element.
+func (it *Iterator[K, V]) Valid() bool {
+	return it.position == atNode
+}
+
+// Key returns the key of the current element. It must be called only if the iterator is valid.
+func (it *Iterator[K, V]) Key() K {
+	return it.node.key
+}
+
+// Value returns the value of the current element. It must be called only if the iterator is valid.
+func (it *Iterator[K, V]) Value() V {
+	return it.node.value
+}
+
+func (it *Iterator[K, V]) moveTo(n *node[K, V], outside iteratorPosition) {
+	it.node = n
+	it.position = atNode
+	if n == nil {
+		it.position = outside
+	}
+}
@@ -0,0 +1,62 @@
+package redblack_test
+
+import (
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/redblack"
+	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/slices"
+)
+
+func TestIterator(t *testing.T) {
+	tree := redblack.New[int, string](generic.Less[int])
+	it := tree.Iterator()
+	if it.Next() || it.Prev() || it.Seek(1) {
+		t.Fatal("didn't expected the iterator of an empty tree to move")
+	}
+
+	for _, key := range []int{10, 20, 30, 40, 50} {
+		tree.Insert(key, "")
+	}
+
+	it = tree.Iterator()
+	keys := make([]int, 0)
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	expected := []int{10, 20, 30, 40, 50}
+	if !slices.Equal(keys, expected) {
+		t.Fatalf("expected keys %v, got %v", expected, keys)
+	}
+	if it.Valid() {
+		t.Fatal("didn't expected the iterator to be valid after the last element")
+	}
+
+	keys = keys[:0]
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	expected = []int{50, 40, 30, 20, 10}
+	if !slices.Equal(keys, expected) {
+		t.Fatalf("expected keys %v, got %v", expected, keys)
+	}
+
+	if !it.Seek(25) || it.Key() != 30 {
+		t.Fatal("expected seek to position the iterator on key 30")
+	}
+	if !it.Next() || it.Key() != 40 {
+		t.Fatal("expected the iterator to move to key 40")
+	}
+	if !it.Prev() || it.Key() != 30 {
+		t.Fatal("expected the iterator to move back to key 30")
+	}
+	if !it.Seek(10) || it.Key() != 10 || it.Prev() {
+		t.Fatal("expected seek to position the iterator on the first element")
+	}
+	if it.Seek(60) || it.Valid() {
+		t.Fatal("didn't expected seek past the last key to find an element")
+	}
+	if !it.Prev() || it.Key() != 50 {
+		t.Fatal("expected the iterator to move back to the last element")
+	}
+}
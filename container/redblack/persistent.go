@@ -0,0 +1,380 @@
+package redblack
+
+import (
+	"fmt"
+
+	"github.com/playgroundgo/genlib/generic"
+)
+
+type persistentNode[K, V any] struct {
+	key      K
+	value    V
+	children [2]*persistentNode[K, V]
+	color    nodeColor
+}
+
+// PersistentTree implements an immutable red-black tree. Every modification returns a new version
+// of the tree which shares all the unchanged nodes with the previous one, so keeping a snapshot
+// is as cheap as keeping a pointer. A version is never modified after it was created, so it can
+// be read from multiple goroutines without synchronization.
+type PersistentTree[K, V any] struct {
+	root *persistentNode[K, V]
+	less generic.LessFn[K]
+	size uint32
+}
+
+// NewPersistent returns an empty persistent red-black tree.
+func NewPersistent[K, V any](less generic.LessFn[K]) *PersistentTree[K, V] {
+	return &PersistentTree[K, V]{
+		less: less,
+	}
+}
+
+// IsEmpty returns 'true' if the tree has no elements.
+func (t *PersistentTree[K, V]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Size returns the number of elements in the tree.
+func (t *PersistentTree[K, V]) Size() uint32 {
+	return t.size
+}
+
+// Find returns the value associated with the given key.
+func (t *PersistentTree[K, V]) Find(key K) (V, bool) {
+	n := t.root
+	for n != nil {
+		compare := generic.CompareBy(key, n.key, t.less)
+		switch {
+		case compare < 0:
+			n = n.children[left]
+		case compare > 0:
+			n = n.children[right]
+		default:
+			return n.value, true
+		}
+	}
+	var tmp V
+	return tmp, false
+}
+
+// Insert returns a new version of the tree containing the given key-value pair. If the key is
+// already present the same version is returned.
+func (t *PersistentTree[K, V]) Insert(key K, value V) *PersistentTree[K, V] {
+	return t.insert(key, value, false)
+}
+
+// Upsert returns a new version of the tree containing the given key-value pair, replacing the
+// value if the key is already present.
+func (t *PersistentTree[K, V]) Upsert(key K, value V) *PersistentTree[K, V] {
+	return t.insert(key, value, true)
+}
+
+// Delete returns a new version of the tree without the given key and the value associated with
+// it. If the key is not present the same version is returned.
+func (t *PersistentTree[K, V]) Delete(key K) (*PersistentTree[K, V], V, bool) {
+	value, ok := t.Find(key)
+	if !ok {
+		return t, value, false
+	}
+	return &PersistentTree[K, V]{
+		root: t.delete(t.root, key).blacken(),
+		less: t.less,
+		size: t.size - 1,
+	}, value, true
+}
+
+// Min returns the smallest key in the tree and its associated value.
+func (t *PersistentTree[K, V]) Min() (K, V, bool) {
+	return t.root.extreme(left).entry()
+}
+
+// Max returns the largest key in the tree and its associated value.
+func (t *PersistentTree[K, V]) Max() (K, V, bool) {
+	return t.root.extreme(right).entry()
+}
+
+// Ascend calls the function 'f' for each key-value pair in ascending key order while the function
+// returns true.
+func (t *PersistentTree[K, V]) Ascend(f func(key K, value V) bool) {
+	t.root.walk(left, f)
+}
+
+// Descend calls the function 'f' for each key-value pair in descending key order while the
+// function returns true.
+func (t *PersistentTree[K, V]) Descend(f func(key K, value V) bool) {
+	t.root.walk(right, f)
+}
+
+// Validate verifies the structural invariants of the tree: the binary search tree ordering, the
+// black root, the absence of red nodes having red children and the equality of black heights
+// across all paths. It returns an error describing the first violation found.
+func (t *PersistentTree[K, V]) Validate() error {
+	if t.root.isRed() {
+		return fmt.Errorf("root node %v is red", t.root.key)
+	}
+	var prev *persistentNode[K, V]
+	var size uint32
+	var err error
+	t.root.walkNodes(func(n *persistentNode[K, V]) bool {
+		if prev != nil && !t.less(prev.key, n.key) {
+			err = fmt.Errorf("node %v is not greater than its predecessor %v", n.key, prev.key)
+			return false
+		}
+		prev = n
+		size++
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if size != t.size {
+		return fmt.Errorf("tree reports size %d, but contains %d nodes", t.size, size)
+	}
+	_, err = t.root.blackHeight()
+	return err
+}
+
+func (t *PersistentTree[K, V]) insert(key K, value V, replace bool) *PersistentTree[K, V] {
+	root, inserted := t.insertNode(t.root, key, value, replace)
+	if root == t.root {
+		return t
+	}
+	result := &PersistentTree[K, V]{
+		root: root.blacken(),
+		less: t.less,
+		size: t.size,
+	}
+	if inserted {
+		result.size++
+	}
+	return result
+}
+
+// insertNode returns the subtree rooted at 'n' with the given key-value pair added. The same
+// subtree is returned if nothing was changed.
+func (t *PersistentTree[K, V]) insertNode(n *persistentNode[K, V], key K, value V,
+	replace bool) (*persistentNode[K, V], bool) {
+	if n == nil {
+		return &persistentNode[K, V]{key: key, value: value, color: red}, true
+	}
+
+	compare := generic.CompareBy(key, n.key, t.less)
+	if compare == 0 {
+		if !replace {
+			return n, false
+		}
+		updated := *n
+		updated.value = value
+		return &updated, false
+	}
+
+	dir := left
+	if compare > 0 {
+		dir = right
+	}
+	child, inserted := t.insertNode(n.children[dir], key, value, replace)
+	if child == n.children[dir] {
+		return n, false
+	}
+	children := n.children
+	children[dir] = child
+	if n.color == black {
+		return balance(children[left], n, children[right]), inserted
+	}
+	return build(red, children[left], n, children[right]), inserted
+}
+
+// delete returns the subtree rooted at 'n' without the given key, which must be present in the
+// subtree.
+func (t *PersistentTree[K, V]) delete(n *persistentNode[K, V], key K) *persistentNode[K, V] {
+	compare := generic.CompareBy(key, n.key, t.less)
+	switch {
+	case compare < 0:
+		if n.children[left].isBlack() {
+			return balanceLeft(t.delete(n.children[left], key), n, n.children[right])
+		}
+		return build(red, t.delete(n.children[left], key), n, n.children[right])
+	case compare > 0:
+		if n.children[right].isBlack() {
+			return balanceRight(n.children[left], n, t.delete(n.children[right], key))
+		}
+		return build(red, n.children[left], n, t.delete(n.children[right], key))
+	default:
+		return concat(n.children[left], n.children[right])
+	}
+}
+
+// build creates a new node with the given color and children, holding the key-value pair of 'x'.
+func build[K, V any](color nodeColor, l, x, r *persistentNode[K, V]) *persistentNode[K, V] {
+	return &persistentNode[K, V]{
+		key:      x.key,
+		value:    x.value,
+		children: [2]*persistentNode[K, V]{l, r},
+		color:    color,
+	}
+}
+
+// balance builds a black node from the given subtrees, fixing a red node having a red child in
+// any of them.
+func balance[K, V any](l, x, r *persistentNode[K, V]) *persistentNode[K, V] {
+	switch {
+	case l.isRed() && r.isRed():
+		return build(red, l.withColor(black), x, r.withColor(black))
+	case l.isRed() && l.children[left].isRed():
+		return build(red, l.children[left].withColor(black), l,
+			build(black, l.children[right], x, r))
+	case l.isRed() && l.children[right].isRed():
+		lr := l.children[right]
+		return build(red, build(black, l.children[left], l, lr.children[left]), lr,
+			build(black, lr.children[right], x, r))
+	case r.isRed() && r.children[right].isRed():
+		return build(red, build(black, l, x, r.children[left]), r,
+			r.children[right].withColor(black))
+	case r.isRed() && r.children[left].isRed():
+		rl := r.children[left]
+		return build(red, build(black, l, x, rl.children[left]), rl,
+			build(black, rl.children[right], r, r.children[right]))
+	}
+	return build(black, l, x, r)
+}
+
+// balanceLeft builds a node from the given subtrees when the black height of the left subtree
+// was reduced by one.
+func balanceLeft[K, V any](l, x, r *persistentNode[K, V]) *persistentNode[K, V] {
+	switch {
+	case l.isRed():
+		return build(red, l.withColor(black), x, r)
+	case r.isBlack():
+		return balance(l, x, r.withColor(red))
+	default:
+		rl := r.children[left]
+		return build(red, build(black, l, x, rl.children[left]), rl,
+			balance(rl.children[right], r, r.children[right].withColor(red)))
+	}
+}
+
+// balanceRight builds a node from the given subtrees when the black height of the right subtree
+// was reduced by one.
+func balanceRight[K, V any](l, x, r *persistentNode[K, V]) *persistentNode[K, V] {
+	switch {
+	case r.isRed():
+		return build(red, l, x, r.withColor(black))
+	case l.isBlack():
+		return balance(l.withColor(red), x, r)
+	default:
+		lr := l.children[right]
+		return build(red, balance(l.children[left].withColor(red), l, lr.children[left]), lr,
+			build(black, lr.children[right], x, r))
+	}
+}
+
+// concat concatenates two subtrees having the same black height, with all the keys of 'l' being
+// less than the keys of 'r'.
+func concat[K, V any](l, r *persistentNode[K, V]) *persistentNode[K, V] {
+	switch {
+	case l == nil:
+		return r
+	case r == nil:
+		return l
+	case l.isRed() && r.isRed():
+		m := concat(l.children[right], r.children[left])
+		if m.isRed() {
+			return build(red, build(red, l.children[left], l, m.children[left]), m,
+				build(red, m.children[right], r, r.children[right]))
+		}
+		return build(red, l.children[left], l, build(red, m, r, r.children[right]))
+	case l.isBlack() && r.isBlack():
+		m := concat(l.children[right], r.children[left])
+		if m.isRed() {
+			return build(red, build(black, l.children[left], l, m.children[left]), m,
+				build(black, m.children[right], r, r.children[right]))
+		}
+		return balanceLeft(l.children[left], l, build(black, m, r, r.children[right]))
+	case r.isRed():
+		return build(red, concat(l, r.children[left]), r, r.children[right])
+	default:
+		return build(red, l.children[left], l, concat(l.children[right], r))
+	}
+}
+
+func (n *persistentNode[K, V]) isRed() bool {
+	return n != nil && n.color == red
+}
+
+func (n *persistentNode[K, V]) isBlack() bool {
+	return n != nil && n.color == black
+}
+
+func (n *persistentNode[K, V]) withColor(color nodeColor) *persistentNode[K, V] {
+	recolored := *n
+	recolored.color = color
+	return &recolored
+}
+
+func (n *persistentNode[K, V]) blacken() *persistentNode[K, V] {
+	if n.isRed() {
+		return n.withColor(black)
+	}
+	return n
+}
+
+func (n *persistentNode[K, V]) entry() (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
+
+func (n *persistentNode[K, V]) extreme(dir int) *persistentNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	for n.children[dir] != nil {
+		n = n.children[dir]
+	}
+	return n
+}
+
+// walk calls the function 'f' for each key-value pair in the subtree, starting from the given
+// direction, while the function returns true.
+func (n *persistentNode[K, V]) walk(dir int, f func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.children[dir].walk(dir, f) && f(n.key, n.value) && n.children[1-dir].walk(dir, f)
+}
+
+func (n *persistentNode[K, V]) walkNodes(f func(n *persistentNode[K, V]) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.children[left].walkNodes(f) && f(n) && n.children[right].walkNodes(f)
+}
+
+func (n *persistentNode[K, V]) blackHeight() (int, error) {
+	if n == nil {
+		return 1, nil
+	}
+	var heights [2]int
+	for dir, child := range n.children {
+		if n.isRed() && child.isRed() {
+			return 0, fmt.Errorf("red node %v has a red child %v", n.key, child.key)
+		}
+		height, err := child.blackHeight()
+		if err != nil {
+			return 0, err
+		}
+		heights[dir] = height
+	}
+	if heights[left] != heights[right] {
+		return 0, fmt.Errorf("node %v has different black heights: %d and %d", n.key,
+			heights[left], heights[right])
+	}
+	if n.color == black {
+		return heights[left] + 1, nil
+	}
+	return heights[left], nil
+}
@@ -0,0 +1,136 @@
+package redblack_test
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/redblack"
+	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+func TestPersistentTree(t *testing.T) {
+	empty := redblack.NewPersistent[int, string](generic.Less[int])
+	v1 := empty.Insert(1, "one").Insert(2, "two").Insert(3, "three")
+	v2 := v1.Upsert(2, "deux")
+	v3, value, ok := v2.Delete(1)
+
+	if !empty.IsEmpty() || v1.Size() != 3 || v2.Size() != 3 || v3.Size() != 2 {
+		t.Fatalf("unexpected sizes %d, %d, %d, %d", empty.Size(), v1.Size(), v2.Size(), v3.Size())
+	}
+	if !ok || value != "one" {
+		t.Fatalf("expected to delete 'one' for key 1, got %q", value)
+	}
+	if value, _ := v1.Find(2); value != "two" {
+		t.Fatalf("expected the old version to keep 'two' for key 2, got %q", value)
+	}
+	if value, _ := v2.Find(2); value != "deux" {
+		t.Fatalf("expected the new version to have 'deux' for key 2, got %q", value)
+	}
+	if _, ok := v3.Find(1); ok {
+		t.Fatal("didn't expected to find key 1 after deletion")
+	}
+	if _, ok := v2.Find(1); !ok {
+		t.Fatal("expected the old version to keep key 1")
+	}
+	if v3.Insert(2, "zwei") != v3 {
+		t.Fatal("expected inserting an existing key to return the same version")
+	}
+	if same, _, ok := v3.Delete(5); ok || same != v3 {
+		t.Fatal("expected deleting a missing key to return the same version")
+	}
+	if key, _, _ := v3.Min(); key != 2 {
+		t.Fatalf("expected minimum 2, got %d", key)
+	}
+	if key, _, _ := v3.Max(); key != 3 {
+		t.Fatalf("expected maximum 3, got %d", key)
+	}
+
+	keys := make([]int, 0)
+	v1.Descend(func(key int, _ string) bool {
+		keys = append(keys, key)
+		return true
+	})
+	expected := []int{3, 2, 1}
+	if !slices.Equal(keys, expected) {
+		t.Fatalf("expected keys %v, got %v", expected, keys)
+	}
+}
+
+func TestPersistentTreeSnapshots(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	tree := redblack.NewPersistent[int, int](generic.Less[int])
+	expected := make(map[int]int)
+
+	type snapshot struct {
+		tree     *redblack.PersistentTree[int, int]
+		expected map[int]int
+	}
+	snapshots := make([]snapshot, 0)
+
+	for i := 0; i < 20000; i++ {
+		key := rnd.Intn(500)
+		if rnd.Intn(3) == 0 {
+			tree, _, _ = tree.Delete(key)
+			delete(expected, key)
+		} else {
+			tree = tree.Upsert(key, i)
+			expected[key] = i
+		}
+		if i%500 == 0 {
+			snapshots = append(snapshots, snapshot{tree, maps.Clone(expected)})
+		}
+	}
+	snapshots = append(snapshots, snapshot{tree, expected})
+
+	for _, s := range snapshots {
+		if err := s.tree.Validate(); err != nil {
+			t.Fatalf("invalid tree: %v", err)
+		}
+		keys := maps.Keys(s.expected)
+		slices.Sort(keys)
+		i := 0
+		s.tree.Ascend(func(key, value int) bool {
+			if key != keys[i] || value != s.expected[key] {
+				t.Fatalf("unexpected element (%d, %d) at position %d", key, value, i)
+			}
+			i++
+			return true
+		})
+		if i != len(keys) {
+			t.Fatalf("expected %d elements in the snapshot, got %d", len(keys), i)
+		}
+	}
+}
+
+func TestPersistentTreeConcurrentReaders(t *testing.T) {
+	tree := redblack.NewPersistent[int, int](generic.Less[int])
+	for i := 0; i < 1000; i++ {
+		tree = tree.Insert(i, i)
+	}
+	snapshot := tree
+
+	var wg sync.WaitGroup
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				if value, ok := snapshot.Find(i); !ok || value != i {
+					t.Errorf("expected to find %d in the snapshot, got %d", i, value)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 1000; i += 2 {
+		tree, _, _ = tree.Delete(i)
+	}
+	wg.Wait()
+
+	if tree.Size() != 500 || snapshot.Size() != 1000 {
+		t.Fatalf("unexpected sizes %d and %d", tree.Size(), snapshot.Size())
+	}
+}
@@ -9,19 +9,26 @@ const (
 	red
 )
 
+const (
+	left  = 0
+	right = 1
+)
+
 type node[K, V any] struct {
 	key      K
 	value    V
 	children [2]*node[K, V]
 	parent   *node[K, V]
+	color    nodeColor
+	// size is the number of nodes in the subtree rooted at this node.
+	size uint32
 }
 
 // Tree implements a red-black tree.
 type Tree[K, V any] struct {
-	root  *node[K, V]
-	less  generic.LessFn[K]
-	size  uint32
-	color nodeColor
+	root *node[K, V]
+	less generic.LessFn[K]
+	size uint32
 }
 
 // New returns an empty red-black tree.
@@ -31,33 +38,452 @@ func New[K, V any](less generic.LessFn[K]) *Tree[K, V] {
 	}
 }
 
+// IsEmpty returns 'true' if the tree has no elements.
 func (t *Tree[K, V]) IsEmpty() bool {
 	return t.root == nil
 }
 
+// Size returns the number of elements in the tree.
 func (t *Tree[K, V]) Size() uint32 {
 	return t.size
 }
 
+// Find returns a pointer to the value associated with the given key.
 func (t *Tree[K, V]) Find(key K) (*V, bool) {
-	if t.root == nil {
+	n := t.findNode(key)
+	if n == nil {
 		return nil, false
 	}
+	return &n.value, true
+}
+
+// Insert adds a new key-value pair to the tree. If the key is already present the tree is left
+// unchanged and 'false' is returned.
+func (t *Tree[K, V]) Insert(key K, value V) bool {
+	_, inserted := t.insert(key, value, false)
+	return inserted
+}
+
+// Upsert adds a new key-value pair to the tree or replaces the value if the key is already
+// present. It returns 'true' if a new element was inserted.
+func (t *Tree[K, V]) Upsert(key K, value V) bool {
+	_, inserted := t.insert(key, value, true)
+	return inserted
+}
+
+// Delete removes the given key from the tree and returns the value associated with it.
+func (t *Tree[K, V]) Delete(key K) (V, bool) {
+	n := t.findNode(key)
+	if n == nil {
+		var tmp V
+		return tmp, false
+	}
+	value := n.value
+	t.deleteNode(n)
+	return value, true
+}
+
+// Min returns the smallest key in the tree and its associated value.
+func (t *Tree[K, V]) Min() (K, V, bool) {
+	return t.root.extreme(left).entry()
+}
+
+// Max returns the largest key in the tree and its associated value.
+func (t *Tree[K, V]) Max() (K, V, bool) {
+	return t.root.extreme(right).entry()
+}
 
-	node := t.root
+// Floor returns the largest key less than or equal to the given key and its associated value.
+func (t *Tree[K, V]) Floor(key K) (K, V, bool) {
+	return t.nearest(key, left, true).entry()
+}
 
-	for node != nil {
-		compare := generic.CompareBy(key, node.key, t.less)
+// Ceiling returns the smallest key greater than or equal to the given key and its associated
+// value.
+func (t *Tree[K, V]) Ceiling(key K) (K, V, bool) {
+	return t.nearest(key, right, true).entry()
+}
+
+// Lower returns the largest key strictly less than the given key and its associated value.
+func (t *Tree[K, V]) Lower(key K) (K, V, bool) {
+	return t.nearest(key, left, false).entry()
+}
+
+// Higher returns the smallest key strictly greater than the given key and its associated value.
+func (t *Tree[K, V]) Higher(key K) (K, V, bool) {
+	return t.nearest(key, right, false).entry()
+}
+
+// PopMin removes the smallest key from the tree and returns it together with its associated value.
+func (t *Tree[K, V]) PopMin() (K, V, bool) {
+	return t.pop(left)
+}
+
+// PopMax removes the largest key from the tree and returns it together with its associated value.
+func (t *Tree[K, V]) PopMax() (K, V, bool) {
+	return t.pop(right)
+}
+
+// Rank returns the number of keys in the tree strictly less than the given key.
+func (t *Tree[K, V]) Rank(key K) int {
+	rank := 0
+	for n := t.root; n != nil; {
+		if t.less(n.key, key) {
+			rank += int(n.children[left].subtreeSize()) + 1
+			n = n.children[right]
+		} else {
+			n = n.children[left]
+		}
+	}
+	return rank
+}
+
+// Select returns the key-value pair found at the index 'i' in the ascending order of the keys.
+func (t *Tree[K, V]) Select(i int) (K, V, bool) {
+	if i < 0 || i >= int(t.size) {
+		var key K
+		var value V
+		return key, value, false
+	}
+	n := t.root
+	for {
+		leftSize := int(n.children[left].subtreeSize())
+		switch {
+		case i < leftSize:
+			n = n.children[left]
+		case i > leftSize:
+			i -= leftSize + 1
+			n = n.children[right]
+		default:
+			return n.entry()
+		}
+	}
+}
+
+// CountRange returns the number of keys in the range [lo, hi).
+func (t *Tree[K, V]) CountRange(lo, hi K) int {
+	if !t.less(lo, hi) {
+		return 0
+	}
+	return t.Rank(hi) - t.Rank(lo)
+}
+
+// Ascend calls the function 'f' for each key-value pair in ascending key order while the function
+// returns true.
+func (t *Tree[K, V]) Ascend(f func(key K, value V) bool) {
+	for n := t.root.extreme(left); n != nil; n = n.step(right) {
+		if !f(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// Descend calls the function 'f' for each key-value pair in descending key order while the
+// function returns true.
+func (t *Tree[K, V]) Descend(f func(key K, value V) bool) {
+	for n := t.root.extreme(right); n != nil; n = n.step(left) {
+		if !f(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// AscendRange calls the function 'f' in ascending key order for each key-value pair having the key
+// in the range [lo, hi) while the function returns true.
+func (t *Tree[K, V]) AscendRange(lo, hi K, f func(key K, value V) bool) {
+	for n := t.nearest(lo, right, true); n != nil && t.less(n.key, hi); n = n.step(right) {
+		if !f(n.key, n.value) {
+			return
+		}
+	}
+}
+
+func (t *Tree[K, V]) findNode(key K) *node[K, V] {
+	n := t.root
+	for n != nil {
+		compare := generic.CompareBy(key, n.key, t.less)
 		switch {
 		case compare < 0:
-			node = node.children[0]
+			n = n.children[left]
 		case compare > 0:
-			node = node.children[1]
+			n = n.children[right]
 		default:
-			return &node.value, true
+			return n
+		}
+	}
+	return nil
+}
+
+// nearest returns the closest node to the given key in the given direction. When 'inclusive' is
+// true, a node having exactly the given key is returned if present.
+func (t *Tree[K, V]) nearest(key K, dir int, inclusive bool) *node[K, V] {
+	var result *node[K, V]
+	for n := t.root; n != nil; {
+		compare := generic.CompareBy(n.key, key, t.less)
+		if compare == 0 && inclusive {
+			return n
+		}
+		if (dir == right && compare > 0) || (dir == left && compare < 0) {
+			result = n
+			n = n.children[1-dir]
+		} else {
+			n = n.children[dir]
+		}
+	}
+	return result
+}
+
+func (t *Tree[K, V]) pop(dir int) (K, V, bool) {
+	n := t.root.extreme(dir)
+	key, value, ok := n.entry()
+	if ok {
+		t.deleteNode(n)
+	}
+	return key, value, ok
+}
+
+func (t *Tree[K, V]) insert(key K, value V, replace bool) (*node[K, V], bool) {
+	var parent *node[K, V]
+	dir := left
+	for n := t.root; n != nil; n = n.children[dir] {
+		compare := generic.CompareBy(key, n.key, t.less)
+		if compare == 0 {
+			if replace {
+				n.value = value
+			}
+			return n, false
+		}
+		parent = n
+		dir = left
+		if compare > 0 {
+			dir = right
+		}
+	}
+
+	n := &node[K, V]{key: key, value: value, parent: parent, color: red, size: 1}
+	if parent == nil {
+		t.root = n
+	} else {
+		parent.children[dir] = n
+	}
+	for p := parent; p != nil; p = p.parent {
+		p.size++
+	}
+	t.size++
+	t.insertFixup(n)
+	return n, true
+}
+
+func (t *Tree[K, V]) insertFixup(n *node[K, V]) {
+	for {
+		parent := n.parent
+		if parent == nil {
+			n.color = black
+			return
+		}
+		if parent.color == black {
+			return
 		}
+		grandparent := parent.parent
+		if grandparent == nil {
+			parent.color = black
+			return
+		}
+		dir := childDir(grandparent, parent)
+		uncle := grandparent.children[1-dir]
+		if isRed(uncle) {
+			parent.color = black
+			uncle.color = black
+			grandparent.color = red
+			n = grandparent
+			continue
+		}
+		if n == parent.children[1-dir] {
+			t.rotate(parent, dir)
+			parent = n
+		}
+		t.rotate(grandparent, 1-dir)
+		parent.color = black
+		grandparent.color = red
+		return
 	}
-	return nil, false
 }
 
-func (t *Tree[K, V]) getSibling()
+func (t *Tree[K, V]) deleteNode(n *node[K, V]) {
+	if n.children[left] != nil && n.children[right] != nil {
+		// Swap the node with its successor instead of copying the successor entry, so that the
+		// nodes keep their entries and the pointers returned by Find stay valid.
+		t.swapWithSuccessor(n, n.children[right].extreme(left))
+	}
+	for p := n.parent; p != nil; p = p.parent {
+		p.size--
+	}
+	t.size--
+
+	child := n.children[left]
+	if child == nil {
+		child = n.children[right]
+	}
+	parent := n.parent
+	if child != nil {
+		// A node with a single child is always black and its child is always red.
+		t.replace(n, child)
+		child.color = black
+		return
+	}
+	if parent == nil {
+		t.root = nil
+		return
+	}
+	dir := childDir(parent, n)
+	parent.children[dir] = nil
+	if n.color == black {
+		t.deleteFixup(parent, dir)
+	}
+}
+
+// swapWithSuccessor exchanges the positions in the tree, the colors and the subtree sizes of the
+// node 'n', which has two children, and its successor 's'.
+func (t *Tree[K, V]) swapWithSuccessor(n, s *node[K, V]) {
+	nRight, sParent, sRight := n.children[right], s.parent, s.children[right]
+	t.replace(n, s)
+	s.children[left] = n.children[left]
+	s.children[left].parent = s
+	if sParent == n {
+		s.children[right] = n
+		n.parent = s
+	} else {
+		s.children[right] = nRight
+		nRight.parent = s
+		sParent.children[left] = n
+		n.parent = sParent
+	}
+	n.children = [2]*node[K, V]{nil, sRight}
+	if sRight != nil {
+		sRight.parent = n
+	}
+	n.color, s.color = s.color, n.color
+	n.size, s.size = s.size, n.size
+}
+
+func (t *Tree[K, V]) deleteFixup(parent *node[K, V], dir int) {
+	for parent != nil {
+		sibling := parent.children[1-dir]
+		if isRed(sibling) {
+			t.rotate(parent, dir)
+			parent.color = red
+			sibling.color = black
+			sibling = parent.children[1-dir]
+		}
+		far := sibling.children[1-dir]
+		near := sibling.children[dir]
+		if !isRed(far) && !isRed(near) {
+			sibling.color = red
+			if parent.color == red {
+				parent.color = black
+				return
+			}
+			n := parent
+			parent = n.parent
+			if parent != nil {
+				dir = childDir(parent, n)
+			}
+			continue
+		}
+		if !isRed(far) {
+			t.rotate(sibling, 1-dir)
+			sibling.color = red
+			near.color = black
+			far = sibling
+			sibling = near
+		}
+		t.rotate(parent, dir)
+		sibling.color = parent.color
+		parent.color = black
+		far.color = black
+		return
+	}
+}
+
+// rotate rotates the subtree rooted at 'n' in the given direction and returns the new root of the
+// subtree.
+func (t *Tree[K, V]) rotate(n *node[K, V], dir int) *node[K, V] {
+	pivot := n.children[1-dir]
+	inner := pivot.children[dir]
+
+	n.children[1-dir] = inner
+	if inner != nil {
+		inner.parent = n
+	}
+	t.replace(n, pivot)
+	pivot.children[dir] = n
+	n.parent = pivot
+
+	pivot.size = n.size
+	n.size = n.children[left].subtreeSize() + n.children[right].subtreeSize() + 1
+	return pivot
+}
+
+// replace puts 'other' in the place of 'n' in the tree.
+func (t *Tree[K, V]) replace(n, other *node[K, V]) {
+	parent := n.parent
+	if parent == nil {
+		t.root = other
+	} else {
+		parent.children[childDir(parent, n)] = other
+	}
+	if other != nil {
+		other.parent = parent
+	}
+}
+
+// entry returns the key and the value of the node or zero values if the node is nil.
+func (n *node[K, V]) entry() (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
+
+func (n *node[K, V]) subtreeSize() uint32 {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// extreme returns the leftmost or the rightmost node of the subtree rooted at 'n'.
+func (n *node[K, V]) extreme(dir int) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	for n.children[dir] != nil {
+		n = n.children[dir]
+	}
+	return n
+}
+
+// step returns the in-order successor of 'n' when 'dir' is right or the in-order predecessor when
+// 'dir' is left.
+func (n *node[K, V]) step(dir int) *node[K, V] {
+	if n.children[dir] != nil {
+		return n.children[dir].extreme(1 - dir)
+	}
+	for n.parent != nil && n == n.parent.children[dir] {
+		n = n.parent
+	}
+	return n.parent
+}
+
+func childDir[K, V any](parent, child *node[K, V]) int {
+	if parent.children[left] == child {
+		return left
+	}
+	return right
+}
+
+func isRed[K, V any](n *node[K, V]) bool {
+	return n != nil && n.color == red
+}
@@ -0,0 +1,277 @@
+package redblack_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/redblack"
+	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+func TestInsertFindDelete(t *testing.T) {
+	tree := redblack.New[int, string](generic.Less[int])
+	if !tree.IsEmpty() {
+		t.Fatal("expected the new tree to be empty")
+	}
+
+	if !tree.Insert(2, "two") || !tree.Insert(1, "one") || !tree.Insert(3, "three") {
+		t.Fatal("expected new keys to be inserted")
+	}
+	if tree.Insert(2, "deux") {
+		t.Fatal("didn't expected an existing key to be inserted")
+	}
+	if value, ok := tree.Find(2); !ok || *value != "two" {
+		t.Fatalf("expected to find 'two' for key 2, got %v", value)
+	}
+
+	if tree.Upsert(2, "deux") {
+		t.Fatal("didn't expected upsert of an existing key to insert a new element")
+	}
+	if !tree.Upsert(4, "four") {
+		t.Fatal("expected upsert of a new key to insert a new element")
+	}
+	if value, ok := tree.Find(2); !ok || *value != "deux" {
+		t.Fatalf("expected to find 'deux' for key 2, got %v", value)
+	}
+	if tree.Size() != 4 {
+		t.Fatalf("expected 4 elements in the tree, got %d", tree.Size())
+	}
+
+	value, ok := tree.Delete(2)
+	if !ok || value != "deux" {
+		t.Fatalf("expected to delete 'deux' for key 2, got %v", value)
+	}
+	if _, ok := tree.Delete(2); ok {
+		t.Fatal("didn't expected to delete a missing key")
+	}
+	if _, ok := tree.Find(2); ok {
+		t.Fatal("didn't expected to find key 2 after deletion")
+	}
+	if tree.Size() != 3 {
+		t.Fatalf("expected 3 elements in the tree, got %d", tree.Size())
+	}
+}
+
+func TestRandomOperations(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	tree := redblack.New[int, int](generic.Less[int])
+	expected := make(map[int]int)
+
+	for i := 0; i < 10000; i++ {
+		key := rnd.Intn(1000)
+		if rnd.Intn(3) == 0 {
+			_, wantOk := expected[key]
+			delete(expected, key)
+			if _, ok := tree.Delete(key); ok != wantOk {
+				t.Fatalf("unexpected delete result for key %d: %v", key, ok)
+			}
+		} else {
+			expected[key] = i
+			tree.Upsert(key, i)
+		}
+		if int(tree.Size()) != len(expected) {
+			t.Fatalf("expected %d elements in the tree, got %d", len(expected), tree.Size())
+		}
+	}
+
+	for key, want := range expected {
+		value, ok := tree.Find(key)
+		if !ok || *value != want {
+			t.Fatalf("expected to find %d for key %d, got %v", want, key, value)
+		}
+	}
+}
+
+func TestFindPointerStability(t *testing.T) {
+	tree := redblack.New[int, int](generic.Less[int])
+	for _, key := range []int{1, 2, 3} {
+		tree.Insert(key, key)
+	}
+	p, _ := tree.Find(3)
+	tree.Delete(2)
+	*p = 300
+	if value, ok := tree.Find(3); !ok || *value != 300 {
+		t.Fatalf("expected the write through the pointer to be kept, got %v", *value)
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	tree = redblack.New[int, int](generic.Less[int])
+	pointers := make(map[int]*int)
+	for key := 0; key < 1000; key++ {
+		tree.Insert(key, key)
+	}
+	for key := 0; key < 1000; key++ {
+		pointers[key], _ = tree.Find(key)
+	}
+	for _, key := range rnd.Perm(1000)[:500] {
+		tree.Delete(key)
+		delete(pointers, key)
+		if err := tree.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for key, p := range pointers {
+		*p = -key
+	}
+	for key := range pointers {
+		if value, ok := tree.Find(key); !ok || *value != -key {
+			t.Fatalf("expected the write through the pointer of key %d to be kept", key)
+		}
+	}
+}
+
+func TestOrderedIteration(t *testing.T) {
+	tree := redblack.New[int, int](generic.Less[int])
+	for _, key := range []int{5, 3, 8, 1, 4, 7, 9, 2, 6} {
+		tree.Insert(key, key*10)
+	}
+
+	collect := func(iterate func(f func(key, value int) bool), limit int) []int {
+		keys := make([]int, 0)
+		iterate(func(key, value int) bool {
+			if value != key*10 {
+				t.Fatalf("expected value %d for key %d, got %d", key*10, key, value)
+			}
+			keys = append(keys, key)
+			return len(keys) < limit
+		})
+		return keys
+	}
+
+	keys := collect(tree.Ascend, 100)
+	expected := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if !slices.Equal(keys, expected) {
+		t.Fatalf("expected ascending keys %v, got %v", expected, keys)
+	}
+
+	keys = collect(tree.Descend, 3)
+	expected = []int{9, 8, 7}
+	if !slices.Equal(keys, expected) {
+		t.Fatalf("expected descending keys %v, got %v", expected, keys)
+	}
+
+	keys = collect(func(f func(key, value int) bool) { tree.AscendRange(3, 7, f) }, 100)
+	expected = []int{3, 4, 5, 6}
+	if !slices.Equal(keys, expected) {
+		t.Fatalf("expected keys in range %v, got %v", expected, keys)
+	}
+
+	keys = collect(func(f func(key, value int) bool) { tree.AscendRange(10, 20, f) }, 100)
+	if len(keys) != 0 {
+		t.Fatalf("expected no keys in range, got %v", keys)
+	}
+}
+
+func TestNearestKeyLookups(t *testing.T) {
+	tree := redblack.New[int, string](generic.Less[int])
+	if _, _, ok := tree.Min(); ok {
+		t.Fatal("didn't expected to find a minimum in an empty tree")
+	}
+	if _, _, ok := tree.PopMax(); ok {
+		t.Fatal("didn't expected to pop from an empty tree")
+	}
+
+	for _, key := range []int{10, 20, 30, 40} {
+		tree.Insert(key, fmt.Sprint(key))
+	}
+
+	tests := []struct {
+		name   string
+		lookup func(key int) (int, string, bool)
+		key    int
+		want   int
+		wantOk bool
+	}{
+		{"floor exact", tree.Floor, 20, 20, true},
+		{"floor between", tree.Floor, 25, 20, true},
+		{"floor below", tree.Floor, 5, 0, false},
+		{"ceiling exact", tree.Ceiling, 20, 20, true},
+		{"ceiling between", tree.Ceiling, 25, 30, true},
+		{"ceiling above", tree.Ceiling, 45, 0, false},
+		{"lower exact", tree.Lower, 20, 10, true},
+		{"lower between", tree.Lower, 25, 20, true},
+		{"lower first", tree.Lower, 10, 0, false},
+		{"higher exact", tree.Higher, 20, 30, true},
+		{"higher between", tree.Higher, 25, 30, true},
+		{"higher last", tree.Higher, 40, 0, false},
+	}
+	for _, test := range tests {
+		key, value, ok := test.lookup(test.key)
+		if ok != test.wantOk || key != test.want {
+			t.Fatalf("%s(%d): expected (%d, %v), got (%d, %v)", test.name, test.key, test.want,
+				test.wantOk, key, ok)
+		}
+		if ok && value != fmt.Sprint(key) {
+			t.Fatalf("%s(%d): expected value %q, got %q", test.name, test.key, fmt.Sprint(key), value)
+		}
+	}
+
+	if key, _, _ := tree.Min(); key != 10 {
+		t.Fatalf("expected minimum 10, got %d", key)
+	}
+	if key, _, _ := tree.Max(); key != 40 {
+		t.Fatalf("expected maximum 40, got %d", key)
+	}
+	if key, value, ok := tree.PopMin(); !ok || key != 10 || value != "10" {
+		t.Fatalf("expected to pop minimum 10, got %d", key)
+	}
+	if key, value, ok := tree.PopMax(); !ok || key != 40 || value != "40" {
+		t.Fatalf("expected to pop maximum 40, got %d", key)
+	}
+	if tree.Size() != 2 {
+		t.Fatalf("expected 2 elements in the tree, got %d", tree.Size())
+	}
+	if key, _, _ := tree.Min(); key != 20 {
+		t.Fatalf("expected minimum 20, got %d", key)
+	}
+}
+
+func TestOrderStatistics(t *testing.T) {
+	rnd := rand.New(rand.NewSource(7))
+	tree := redblack.New[int, int](generic.Less[int])
+	expected := make(map[int]struct{})
+	for i := 0; i < 5000; i++ {
+		key := rnd.Intn(2000)
+		if rnd.Intn(4) == 0 {
+			tree.Delete(key)
+			delete(expected, key)
+		} else {
+			tree.Insert(key, -key)
+			expected[key] = struct{}{}
+		}
+	}
+	keys := maps.Keys(expected)
+	slices.Sort(keys)
+
+	for i, want := range keys {
+		key, value, ok := tree.Select(i)
+		if !ok || key != want || value != -want {
+			t.Fatalf("expected Select(%d) to return %d, got %d", i, want, key)
+		}
+		if rank := tree.Rank(want); rank != i {
+			t.Fatalf("expected Rank(%d) to be %d, got %d", want, i, rank)
+		}
+	}
+	if _, _, ok := tree.Select(len(keys)); ok {
+		t.Fatal("didn't expected Select to find an element past the end")
+	}
+	if _, _, ok := tree.Select(-1); ok {
+		t.Fatal("didn't expected Select to find an element at a negative index")
+	}
+
+	for i := 0; i < 100; i++ {
+		lo, hi := rnd.Intn(2100)-50, rnd.Intn(2100)-50
+		want := 0
+		for _, key := range keys {
+			if key >= lo && key < hi {
+				want++
+			}
+		}
+		if count := tree.CountRange(lo, hi); count != want {
+			t.Fatalf("expected CountRange(%d, %d) to be %d, got %d", lo, hi, want, count)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package redblack
+
+import "fmt"
+
+// Validate verifies the structural invariants of the tree: the binary search tree ordering, the
+// parent links, the subtree sizes, the black root, the absence of red nodes having red children
+// and the equality of black heights across all paths. It returns an error describing the first
+// violation found.
+func (t *Tree[K, V]) Validate() error {
+	if t.root == nil {
+		if t.size != 0 {
+			return fmt.Errorf("empty tree reports size %d", t.size)
+		}
+		return nil
+	}
+	if t.root.parent != nil {
+		return fmt.Errorf("root node %v has a parent", t.root.key)
+	}
+	if t.root.color != black {
+		return fmt.Errorf("root node %v is red", t.root.key)
+	}
+	if _, err := t.validateNode(t.root, nil, nil); err != nil {
+		return err
+	}
+	if t.root.size != t.size {
+		return fmt.Errorf("tree reports size %d, but contains %d nodes", t.size, t.root.size)
+	}
+	return nil
+}
+
+// validateNode validates the subtree rooted at 'n' whose keys must be in the range (lo, hi) and
+// returns its black height.
+func (t *Tree[K, V]) validateNode(n, lo, hi *node[K, V]) (int, error) {
+	if n == nil {
+		return 1, nil
+	}
+	if lo != nil && !t.less(lo.key, n.key) {
+		return 0, fmt.Errorf("node %v is not greater than its predecessor %v", n.key, lo.key)
+	}
+	if hi != nil && !t.less(n.key, hi.key) {
+		return 0, fmt.Errorf("node %v is not less than its successor %v", n.key, hi.key)
+	}
+
+	var heights [2]int
+	for dir, child := range n.children {
+		if child == nil {
+			heights[dir] = 1
+			continue
+		}
+		if child.parent != n {
+			return 0, fmt.Errorf("node %v has an invalid parent link", child.key)
+		}
+		if n.color == red && child.color == red {
+			return 0, fmt.Errorf("red node %v has a red child %v", n.key, child.key)
+		}
+		childLo, childHi := lo, n
+		if dir == right {
+			childLo, childHi = n, hi
+		}
+		height, err := t.validateNode(child, childLo, childHi)
+		if err != nil {
+			return 0, err
+		}
+		heights[dir] = height
+	}
+
+	if heights[left] != heights[right] {
+		return 0, fmt.Errorf("node %v has different black heights: %d and %d", n.key,
+			heights[left], heights[right])
+	}
+	size := n.children[left].subtreeSize() + n.children[right].subtreeSize() + 1
+	if n.size != size {
+		return 0, fmt.Errorf("node %v reports size %d, but its subtree contains %d nodes", n.key,
+			n.size, size)
+	}
+	if n.color == black {
+		return heights[left] + 1, nil
+	}
+	return heights[left], nil
+}
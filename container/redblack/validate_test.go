@@ -0,0 +1,177 @@
+package redblack_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/redblack"
+	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/slices"
+)
+
+// sortedModel is a reference ordered map implementation backed by sorted slices.
+type sortedModel struct {
+	keys   []int
+	values []int
+}
+
+func (m *sortedModel) find(key int) (int, bool) {
+	return slices.BinarySearch(m.keys, key)
+}
+
+func (m *sortedModel) upsert(key, value int) bool {
+	i, found := m.find(key)
+	if found {
+		m.values[i] = value
+		return false
+	}
+	m.keys = slices.Insert(m.keys, i, key)
+	m.values = slices.Insert(m.values, i, value)
+	return true
+}
+
+func (m *sortedModel) delete(i int) (int, int) {
+	key, value := m.keys[i], m.values[i]
+	m.keys = slices.Delete(m.keys, i, i+1)
+	m.values = slices.Delete(m.values, i, i+1)
+	return key, value
+}
+
+func (m *sortedModel) at(i int) (int, int, bool) {
+	if i < 0 || i >= len(m.keys) {
+		return 0, 0, false
+	}
+	return m.keys[i], m.values[i], true
+}
+
+func TestValidateEmptyTree(t *testing.T) {
+	tree := redblack.New[int, int](generic.Less[int])
+	if err := tree.Validate(); err != nil {
+		t.Fatalf("expected an empty tree to be valid, got %v", err)
+	}
+}
+
+func TestRandomizedAgainstModel(t *testing.T) {
+	operations := 1_000_000
+	if testing.Short() {
+		operations = 50_000
+	}
+
+	for _, keySpace := range []int{16, 1024, 8192} {
+		rnd := rand.New(rand.NewSource(int64(keySpace)))
+		tree := redblack.New[int, int](generic.Less[int])
+		model := &sortedModel{}
+
+		for op := 0; op < operations/3; op++ {
+			key := rnd.Intn(keySpace)
+			checkOperation(t, rnd.Intn(10), key, op, tree, model)
+
+			if op%1000 == 0 {
+				if err := tree.Validate(); err != nil {
+					t.Fatalf("invalid tree after %d operations: %v", op, err)
+				}
+				checkContent(t, tree, model)
+			}
+		}
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("invalid tree: %v", err)
+		}
+		checkContent(t, tree, model)
+	}
+}
+
+func checkOperation(t *testing.T, kind, key, value int, tree *redblack.Tree[int, int],
+	model *sortedModel) {
+	t.Helper()
+
+	switch kind {
+	case 0, 1:
+		_, found := model.find(key)
+		if !found {
+			model.upsert(key, value)
+		}
+		if inserted := tree.Insert(key, value); inserted == found {
+			t.Fatalf("Insert(%d): expected inserted to be %v", key, !found)
+		}
+	case 2, 3:
+		inserted := model.upsert(key, value)
+		if got := tree.Upsert(key, value); got != inserted {
+			t.Fatalf("Upsert(%d): expected inserted to be %v", key, inserted)
+		}
+	case 4, 5:
+		i, found := model.find(key)
+		var want int
+		if found {
+			_, want = model.delete(i)
+		}
+		if got, ok := tree.Delete(key); ok != found || got != want {
+			t.Fatalf("Delete(%d): expected (%d, %v), got (%d, %v)", key, want, found, got, ok)
+		}
+	case 6:
+		wantKey, wantValue, wantOk := model.at(0)
+		if wantOk {
+			model.delete(0)
+		}
+		checkEntry(t, "PopMin", key, wantKey, wantValue, wantOk)(tree.PopMin())
+	case 7:
+		wantKey, wantValue, wantOk := model.at(len(model.keys) - 1)
+		if wantOk {
+			model.delete(len(model.keys) - 1)
+		}
+		checkEntry(t, "PopMax", key, wantKey, wantValue, wantOk)(tree.PopMax())
+	case 8:
+		i, found := model.find(key)
+		wantKey, wantValue, wantOk := model.at(i)
+		checkEntry(t, "Ceiling", key, wantKey, wantValue, wantOk)(tree.Ceiling(key))
+		wantKey, wantValue, wantOk = model.at(i - 1)
+		checkEntry(t, "Lower", key, wantKey, wantValue, wantOk)(tree.Lower(key))
+		if found {
+			i++
+		}
+		wantKey, wantValue, wantOk = model.at(i)
+		checkEntry(t, "Higher", key, wantKey, wantValue, wantOk)(tree.Higher(key))
+		wantKey, wantValue, wantOk = model.at(i - 1)
+		checkEntry(t, "Floor", key, wantKey, wantValue, wantOk)(tree.Floor(key))
+	default:
+		i, _ := model.find(key)
+		if rank := tree.Rank(key); rank != i {
+			t.Fatalf("Rank(%d): expected %d, got %d", key, i, rank)
+		}
+		i = len(model.keys) / 2
+		wantKey, wantValue, wantOk := model.at(i)
+		checkEntry(t, "Select", i, wantKey, wantValue, wantOk)(tree.Select(i))
+	}
+
+	if int(tree.Size()) != len(model.keys) {
+		t.Fatalf("expected size %d, got %d", len(model.keys), tree.Size())
+	}
+}
+
+func checkEntry(t *testing.T, name string, arg, wantKey, wantValue int,
+	wantOk bool) func(int, int, bool) {
+	t.Helper()
+
+	return func(key, value int, ok bool) {
+		t.Helper()
+		if ok != wantOk || key != wantKey || value != wantValue {
+			t.Fatalf("%s(%d): expected (%d, %d, %v), got (%d, %d, %v)", name, arg, wantKey,
+				wantValue, wantOk, key, value, ok)
+		}
+	}
+}
+
+func checkContent(t *testing.T, tree *redblack.Tree[int, int], model *sortedModel) {
+	t.Helper()
+
+	i := 0
+	tree.Ascend(func(key, value int) bool {
+		if i >= len(model.keys) || key != model.keys[i] || value != model.values[i] {
+			t.Fatalf("unexpected element (%d, %d) at position %d", key, value, i)
+		}
+		i++
+		return true
+	})
+	if i != len(model.keys) {
+		t.Fatalf("expected %d elements during iteration, got %d", len(model.keys), i)
+	}
+}
@@ -0,0 +1,119 @@
+package ring
+
+import (
+	"fmt"
+
+	"github.com/playgroundgo/genlib/errors"
+	"github.com/playgroundgo/genlib/generic"
+)
+
+// OverflowPolicy describes how a buffer handles the writes when it is full.
+type OverflowPolicy uint8
+
+const (
+	// Overwrite replaces the oldest element of a full buffer with the written one.
+	Overwrite OverflowPolicy = iota
+	// Reject leaves a full buffer unchanged and fails the write with errors.ErrFull.
+	Reject
+)
+
+// Buffer implements a circular buffer holding a fixed number of elements.
+type Buffer[T any] struct {
+	items  []T
+	head   int
+	size   int
+	policy OverflowPolicy
+}
+
+// New returns an empty buffer which can hold up to 'capacity' elements. It panics if the capacity
+// is not positive.
+func New[T any](capacity int, policy OverflowPolicy) *Buffer[T] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("ring: invalid capacity %d", capacity))
+	}
+	return &Buffer[T]{
+		items:  make([]T, capacity),
+		policy: policy,
+	}
+}
+
+// Len returns the number of elements in the buffer.
+func (b *Buffer[T]) Len() int {
+	return b.size
+}
+
+// Cap returns the maximum number of elements the buffer can hold.
+func (b *Buffer[T]) Cap() int {
+	return len(b.items)
+}
+
+// IsEmpty returns 'true' if the buffer has no elements.
+func (b *Buffer[T]) IsEmpty() bool {
+	return b.size == 0
+}
+
+// IsFull returns 'true' if the buffer holds as many elements as its capacity.
+func (b *Buffer[T]) IsFull() bool {
+	return b.size == len(b.items)
+}
+
+// Push adds an element to the buffer. When the buffer is full, the oldest element is overwritten
+// or errors.ErrFull is returned, depending on the overflow policy.
+func (b *Buffer[T]) Push(item T) error {
+	if b.IsFull() {
+		if b.policy == Reject {
+			return errors.ErrFull
+		}
+		b.items[b.head] = item
+		b.head = b.index(1)
+		return nil
+	}
+	b.items[b.index(b.size)] = item
+	b.size++
+	return nil
+}
+
+// Pop removes the oldest element from the buffer and returns it.
+func (b *Buffer[T]) Pop() (T, error) {
+	if b.size == 0 {
+		var tmp T
+		return tmp, errors.ErrEmpty
+	}
+	var zero T
+	item := b.items[b.head]
+	b.items[b.head] = zero
+	b.head = b.index(1)
+	b.size--
+	return item, nil
+}
+
+// Peek returns the oldest element from the buffer without removing it.
+func (b *Buffer[T]) Peek() (T, error) {
+	if b.size == 0 {
+		var tmp T
+		return tmp, errors.ErrEmpty
+	}
+	return b.items[b.head], nil
+}
+
+// Snapshot returns a slice containing all the elements from the buffer in insertion order.
+func (b *Buffer[T]) Snapshot() []T {
+	items := make([]T, b.size)
+	n := copy(items, b.items[b.head:generic.Min(b.head+b.size, len(b.items))])
+	copy(items[n:], b.items[:b.size-n])
+	return items
+}
+
+// Clear removes all the elements from the buffer.
+func (b *Buffer[T]) Clear() {
+	var zero T
+	for i := range b.items {
+		b.items[i] = zero
+	}
+	b.head = 0
+	b.size = 0
+}
+
+func (b *Buffer[T]) index(i int) int {
+	return (b.head + i) % len(b.items)
+}
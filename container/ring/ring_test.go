@@ -0,0 +1,77 @@
+package ring_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/ring"
+	gerrors "github.com/playgroundgo/genlib/errors"
+	"golang.org/x/exp/slices"
+)
+
+func TestOverwritePolicy(t *testing.T) {
+	b := ring.New[int](3, ring.Overwrite)
+	if _, err := b.Pop(); !errors.Is(err, gerrors.ErrEmpty) {
+		t.Fatalf("expected empty container error, got %v", err)
+	}
+	if _, err := b.Peek(); !errors.Is(err, gerrors.ErrEmpty) {
+		t.Fatalf("expected empty container error, got %v", err)
+	}
+
+	for i := 1; i <= 5; i++ {
+		if err := b.Push(i); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if !b.IsFull() || b.Len() != 3 || b.Cap() != 3 {
+		t.Fatalf("expected a full buffer with 3 elements, got %d", b.Len())
+	}
+	if expected := []int{3, 4, 5}; !slices.Equal(b.Snapshot(), expected) {
+		t.Fatalf("expected snapshot %v, got %v", expected, b.Snapshot())
+	}
+
+	if item, _ := b.Peek(); item != 3 {
+		t.Fatalf("expected oldest element 3, got %d", item)
+	}
+	if item, _ := b.Pop(); item != 3 {
+		t.Fatalf("expected to pop 3, got %d", item)
+	}
+	b.Push(6)
+	if expected := []int{4, 5, 6}; !slices.Equal(b.Snapshot(), expected) {
+		t.Fatalf("expected snapshot %v, got %v", expected, b.Snapshot())
+	}
+
+	b.Clear()
+	if !b.IsEmpty() || len(b.Snapshot()) != 0 {
+		t.Fatal("expected the buffer to be empty")
+	}
+}
+
+func TestRejectPolicy(t *testing.T) {
+	b := ring.New[string](2, ring.Reject)
+	b.Push("a")
+	b.Push("b")
+	if err := b.Push("c"); !errors.Is(err, gerrors.ErrFull) {
+		t.Fatalf("expected full container error, got %v", err)
+	}
+	if expected := []string{"a", "b"}; !slices.Equal(b.Snapshot(), expected) {
+		t.Fatalf("expected snapshot %v, got %v", expected, b.Snapshot())
+	}
+
+	b.Pop()
+	if err := b.Push("c"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"b", "c"}; !slices.Equal(b.Snapshot(), expected) {
+		t.Fatalf("expected snapshot %v, got %v", expected, b.Snapshot())
+	}
+}
+
+func TestInvalidCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected New to panic for a zero capacity")
+		}
+	}()
+	ring.New[int](0, ring.Overwrite)
+}
@@ -0,0 +1,261 @@
+package set
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/bits"
+	"strings"
+
+	"github.com/playgroundgo/genlib/generic"
+)
+
+const wordSize = 64
+
+// MaxDecodedElement is the largest element accepted when decoding a bit set from JSON, which
+// bounds the memory allocated for an untrusted payload to 32 MiB.
+const MaxDecodedElement = 1<<28 - 1
+
+// maxWords is the largest number of words a bit set can hold.
+const maxWords = math.MaxInt / wordSize
+
+// BitSet implements a dense set of non-negative integers, using one bit for each integer in the
+// range between zero and the largest element. The zero value is an empty set ready to use.
+type BitSet struct {
+	words []uint64
+}
+
+// NewBitSet creates a new bit set having enough space to hold the integers in the range [0, n)
+// without growing.
+func NewBitSet(n uint) *BitSet {
+	return &BitSet{
+		words: make([]uint64, (n+wordSize-1)/wordSize),
+	}
+}
+
+// Set adds the integer 'i' to the set. It panics if the set would need more than math.MaxInt bits.
+func (b *BitSet) Set(i uint) {
+	b.grow(i/wordSize + 1)
+	b.words[i/wordSize] |= 1 << (i % wordSize)
+}
+
+// Clear removes the integer 'i' from the set.
+func (b *BitSet) Clear(i uint) {
+	if i/wordSize < uint(len(b.words)) {
+		b.words[i/wordSize] &^= 1 << (i % wordSize)
+	}
+}
+
+// Test verifies if the integer 'i' belongs to the set.
+func (b *BitSet) Test(i uint) bool {
+	if i/wordSize >= uint(len(b.words)) {
+		return false
+	}
+	return b.words[i/wordSize]&(1<<(i%wordSize)) != 0
+}
+
+// Flip adds the integer 'i' to the set if it is missing or removes it otherwise.
+func (b *BitSet) Flip(i uint) {
+	b.grow(i/wordSize + 1)
+	b.words[i/wordSize] ^= 1 << (i % wordSize)
+}
+
+// Count returns the number of elements in the set.
+func (b *BitSet) Count() int {
+	count := 0
+	for _, word := range b.words {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// Size returns the number of elements in the set.
+func (b *BitSet) Size() int {
+	return b.Count()
+}
+
+// IsEmpty returns 'true' if the set is empty.
+func (b *BitSet) IsEmpty() bool {
+	for _, word := range b.words {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal checks if this set is equal with another one.
+func (b *BitSet) Equal(other *BitSet) bool {
+	short, long := b.words, other.words
+	if len(short) > len(long) {
+		short, long = long, short
+	}
+	for i, word := range short {
+		if word != long[i] {
+			return false
+		}
+	}
+	for _, word := range long[len(short):] {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns a string representation of the set, with the elements in ascending order.
+func (b *BitSet) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	b.ForEach(func(i uint) bool {
+		if sb.Len() > 1 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%d", i))
+		return true
+	})
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// ClearAll clears the content of the set.
+func (b *BitSet) ClearAll() {
+	for i := range b.words {
+		b.words[i] = 0
+	}
+}
+
+// Clone returns a copy of the given set.
+func (b *BitSet) Clone() *BitSet {
+	return &BitSet{
+		words: append([]uint64(nil), b.words...),
+	}
+}
+
+// NextSet returns the smallest element of the set which is greater than or equal to 'i'.
+func (b *BitSet) NextSet(i uint) (uint, bool) {
+	index := i / wordSize
+	if index >= uint(len(b.words)) {
+		return 0, false
+	}
+	word := b.words[index] >> (i % wordSize)
+	if word != 0 {
+		return i + uint(bits.TrailingZeros64(word)), true
+	}
+	for index++; index < uint(len(b.words)); index++ {
+		if b.words[index] != 0 {
+			return index*wordSize + uint(bits.TrailingZeros64(b.words[index])), true
+		}
+	}
+	return 0, false
+}
+
+// ForEach calls the 'f' function for each element in the set, in ascending order, while the
+// function returns true.
+func (b *BitSet) ForEach(f func(i uint) bool) {
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		if !f(i) {
+			return
+		}
+	}
+}
+
+// ToSlice returns a slice containing all the elements from the set in ascending order.
+func (b *BitSet) ToSlice() []uint {
+	elems := make([]uint, 0, b.Count())
+	b.ForEach(func(i uint) bool {
+		elems = append(elems, i)
+		return true
+	})
+	return elems
+}
+
+// Intersect returns the intersection between this set and another one.
+func (b *BitSet) Intersect(other *BitSet) *BitSet {
+	result := &BitSet{
+		words: make([]uint64, generic.Min(len(b.words), len(other.words))),
+	}
+	for i := range result.words {
+		result.words[i] = b.words[i] & other.words[i]
+	}
+	return result
+}
+
+// Union returns the union between this set and another one.
+func (b *BitSet) Union(other *BitSet) *BitSet {
+	result := b.Clone()
+	result.grow(uint(len(other.words)))
+	for i, word := range other.words {
+		result.words[i] |= word
+	}
+	return result
+}
+
+// Difference returns the elements of this set which are not found in another set.
+func (b *BitSet) Difference(other *BitSet) *BitSet {
+	result := b.Clone()
+	for i := range result.words[:generic.Min(len(result.words), len(other.words))] {
+		result.words[i] &^= other.words[i]
+	}
+	return result
+}
+
+// MarshalBinary encodes the set as a sequence of little-endian 64-bit words.
+func (b *BitSet) MarshalBinary() ([]byte, error) {
+	words := b.words
+	for len(words) > 0 && words[len(words)-1] == 0 {
+		words = words[:len(words)-1]
+	}
+	data := make([]byte, len(words)*8)
+	for i, word := range words {
+		binary.LittleEndian.PutUint64(data[i*8:], word)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes a set encoded with MarshalBinary, replacing its content.
+func (b *BitSet) UnmarshalBinary(data []byte) error {
+	if len(data)%8 != 0 {
+		return fmt.Errorf("invalid bit set encoding length %d", len(data))
+	}
+	b.words = make([]uint64, len(data)/8)
+	for i := range b.words {
+		b.words[i] = binary.LittleEndian.Uint64(data[i*8:])
+	}
+	return nil
+}
+
+// MarshalJSON encodes the set as a JSON array of its elements in ascending order.
+func (b *BitSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.ToSlice())
+}
+
+// UnmarshalJSON decodes a JSON array of integers into the set, replacing its content. It fails if
+// any element is greater than MaxDecodedElement.
+func (b *BitSet) UnmarshalJSON(data []byte) error {
+	var elems []uint
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	for _, i := range elems {
+		if i > MaxDecodedElement {
+			return fmt.Errorf("bit set element %d exceeds the maximum %d", i, MaxDecodedElement)
+		}
+	}
+	b.words = nil
+	for _, i := range elems {
+		b.Set(i)
+	}
+	return nil
+}
+
+// grow makes sure that the set has at least 'n' words.
+func (b *BitSet) grow(n uint) {
+	if n > maxWords {
+		panic(fmt.Sprintf("set: bit set of %d words is too large", n))
+	}
+	if n > uint(len(b.words)) {
+		b.words = append(b.words, make([]uint64, n-uint(len(b.words)))...)
+	}
+}
@@ -0,0 +1,157 @@
+package set_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/set"
+	"golang.org/x/exp/slices"
+)
+
+func TestBitSetBasicOperations(t *testing.T) {
+	var b set.BitSet
+	if !b.IsEmpty() || b.Test(3) {
+		t.Fatal("expected the zero value to be an empty set")
+	}
+
+	b.Set(3)
+	b.Set(64)
+	b.Set(200)
+	b.Flip(5)
+	b.Flip(64)
+	b.Clear(3)
+	b.Clear(1000)
+
+	if b.Count() != 2 || !b.Test(5) || !b.Test(200) || b.Test(64) || b.Test(3) {
+		t.Fatalf("unexpected set content %v", &b)
+	}
+	if str := b.String(); str != "{5, 200}" {
+		t.Fatalf("expected string representation {5, 200}, got %s", str)
+	}
+
+	clone := b.Clone()
+	clone.Set(7)
+	if b.Test(7) || b.Equal(clone) {
+		t.Fatal("didn't expected the clone to share elements with the set")
+	}
+	clone.Clear(7)
+	if !b.Equal(clone) || !clone.Equal(&b) {
+		t.Fatal("expected the set to be equal with the clone")
+	}
+
+	b.ClearAll()
+	if !b.IsEmpty() || b.Size() != 0 {
+		t.Fatal("expected the set to be empty")
+	}
+}
+
+func TestBitSetIteration(t *testing.T) {
+	b := set.NewBitSet(256)
+	elems := []uint{0, 1, 63, 64, 65, 127, 128, 255, 700}
+	for _, i := range elems {
+		b.Set(i)
+	}
+
+	if !slices.Equal(b.ToSlice(), elems) {
+		t.Fatalf("expected elements %v, got %v", elems, b.ToSlice())
+	}
+	if next, ok := b.NextSet(66); !ok || next != 127 {
+		t.Fatalf("expected next element 127, got %d", next)
+	}
+	if next, ok := b.NextSet(256); !ok || next != 700 {
+		t.Fatalf("expected next element 700, got %d", next)
+	}
+	if _, ok := b.NextSet(701); ok {
+		t.Fatal("didn't expected an element after 700")
+	}
+
+	collected := make([]uint, 0)
+	b.ForEach(func(i uint) bool {
+		collected = append(collected, i)
+		return i < 64
+	})
+	if !slices.Equal(collected, elems[:4]) {
+		t.Fatalf("expected elements %v, got %v", elems[:4], collected)
+	}
+}
+
+func TestBitSetAlgebra(t *testing.T) {
+	b1, b2 := set.NewBitSet(0), set.NewBitSet(0)
+	for _, i := range []uint{1, 2, 100, 130} {
+		b1.Set(i)
+	}
+	for _, i := range []uint{2, 3, 100} {
+		b2.Set(i)
+	}
+
+	tests := []struct {
+		name     string
+		result   *set.BitSet
+		expected []uint
+	}{
+		{"union", b1.Union(b2), []uint{1, 2, 3, 100, 130}},
+		{"reverse union", b2.Union(b1), []uint{1, 2, 3, 100, 130}},
+		{"intersection", b1.Intersect(b2), []uint{2, 100}},
+		{"difference", b1.Difference(b2), []uint{1, 130}},
+		{"reverse difference", b2.Difference(b1), []uint{3}},
+	}
+	for _, test := range tests {
+		if !slices.Equal(test.result.ToSlice(), test.expected) {
+			t.Fatalf("expected %s %v, got %v", test.name, test.expected, test.result)
+		}
+	}
+}
+
+func TestBitSetEncoding(t *testing.T) {
+	b := set.NewBitSet(1024)
+	for _, i := range []uint{0, 9, 70, 129} {
+		b.Set(i)
+	}
+
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data) != 24 {
+		t.Fatalf("expected 24 bytes of binary encoding, got %d", len(data))
+	}
+	var decoded set.BitSet
+	if err := decoded.UnmarshalBinary(data); err != nil || !decoded.Equal(b) {
+		t.Fatalf("expected %v, got %v (%v)", b, &decoded, err)
+	}
+	if err := decoded.UnmarshalBinary(data[:5]); err == nil {
+		t.Fatal("expected an error for a truncated encoding")
+	}
+
+	data, err = json.Marshal(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != "[0,9,70,129]" {
+		t.Fatalf("expected JSON array [0,9,70,129], got %s", data)
+	}
+	decoded = set.BitSet{}
+	if err := json.Unmarshal(data, &decoded); err != nil || !decoded.Equal(b) {
+		t.Fatalf("expected %v, got %v (%v)", b, &decoded, err)
+	}
+	for _, payload := range []string{"[18446744073709551615]", "[68719476736]", "[1,268435456]"} {
+		if err := json.Unmarshal([]byte(payload), &decoded); err == nil {
+			t.Fatalf("expected an error for the oversized element in %s", payload)
+		}
+		if !decoded.Equal(b) {
+			t.Fatalf("expected a failed decoding to keep %v, got %v", b, &decoded)
+		}
+	}
+	if err := json.Unmarshal([]byte("[268435455]"), &decoded); err != nil ||
+		!decoded.Test(set.MaxDecodedElement) {
+		t.Fatalf("expected to decode the maximum element, got %v", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for an element exceeding the capacity of a bit set")
+		}
+	}()
+	decoded.Set(math.MaxUint)
+}
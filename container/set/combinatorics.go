@@ -0,0 +1,61 @@
+package set
+
+import "github.com/playgroundgo/genlib/generic"
+
+// PowerSet returns all the subsets of the set, including the empty set and the set itself. The
+// number of subsets grows exponentially with the size of the set.
+func (s Set[T]) PowerSet() []Set[T] {
+	subsets := make([]Set[T], 1, 1<<generic.Min(s.Size(), 20))
+	subsets[0] = New[T]()
+	for elem := range s {
+		for _, subset := range subsets {
+			extended := NewWithInitialSpace[T](subset.Size() + 1)
+			extended.UnionInPlace(subset)
+			extended.Add(elem)
+			subsets = append(subsets, extended)
+		}
+	}
+	return subsets
+}
+
+// Partition splits the set into two new sets, the first one holding the elements for which the
+// function 'f' returned true and the second one holding the rest of the elements.
+func (s Set[T]) Partition(f func(elem T) bool) (Set[T], Set[T]) {
+	matched, unmatched := New[T](), New[T]()
+	for elem := range s {
+		if f(elem) {
+			matched.Add(elem)
+		} else {
+			unmatched.Add(elem)
+		}
+	}
+	return matched, unmatched
+}
+
+// Product returns the Cartesian product of two sets, as the set of all the pairs having the first
+// element from 'a' and the second element from 'b'.
+func Product[A, B comparable](a Set[A], b Set[B]) Set[generic.Pair[A, B]] {
+	result := NewWithInitialSpace[generic.Pair[A, B]](a.Size() * b.Size())
+	for first := range a {
+		for second := range b {
+			result.Add(generic.Pair[A, B]{First: first, Second: second})
+		}
+	}
+	return result
+}
+
+// GroupBy splits the set into groups of elements having the same key, as returned by the
+// function 'f'.
+func GroupBy[T, K comparable](s Set[T], f func(elem T) K) map[K]Set[T] {
+	groups := make(map[K]Set[T])
+	for elem := range s {
+		key := f(elem)
+		group, found := groups[key]
+		if !found {
+			group = New[T]()
+			groups[key] = group
+		}
+		group.Add(elem)
+	}
+	return groups
+}
@@ -0,0 +1,79 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/set"
+	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/slices"
+)
+
+func TestPowerSet(t *testing.T) {
+	s := set.New[int]()
+	s.AddAll(1, 2, 3)
+
+	subsets := s.PowerSet()
+	if len(subsets) != 8 {
+		t.Fatalf("expected 8 subsets, got %d", len(subsets))
+	}
+	strs := make([]string, len(subsets))
+	for i, subset := range subsets {
+		if !subset.IsSubsetOf(s) {
+			t.Fatalf("expected %v to be a subset of %v", subset, s)
+		}
+		strs[i] = subset.String()
+	}
+	slices.Sort(strs)
+	expected := []string{"{1, 2, 3}", "{1, 2}", "{1, 3}", "{1}", "{2, 3}", "{2}", "{3}", "{}"}
+	if !slices.Equal(strs, expected) {
+		t.Fatalf("expected subsets %v, got %v", expected, strs)
+	}
+
+	if subsets := set.New[int]().PowerSet(); len(subsets) != 1 || !subsets[0].IsEmpty() {
+		t.Fatalf("expected only the empty subset, got %v", subsets)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	a := set.New[int]()
+	b := set.New[string]()
+	a.AddAll(1, 2)
+	b.AddAll("x", "y", "z")
+
+	product := set.Product(a, b)
+	if product.Size() != 6 {
+		t.Fatalf("expected 6 pairs, got %d", product.Size())
+	}
+	if !product.ContainsAll(generic.Pair[int, string]{First: 1, Second: "x"},
+		generic.Pair[int, string]{First: 2, Second: "z"}) {
+		t.Fatalf("unexpected product %v", product)
+	}
+	if !set.Product(a, set.New[string]()).IsEmpty() {
+		t.Fatal("expected the product with an empty set to be empty")
+	}
+}
+
+func TestPartitionAndGroupBy(t *testing.T) {
+	s := set.New[int]()
+	s.AddAll(1, 2, 3, 4, 5, 6, 7)
+
+	even, odd := s.Partition(func(elem int) bool {
+		return elem%2 == 0
+	})
+	if even.String() != "{2, 4, 6}" || odd.String() != "{1, 3, 5, 7}" {
+		t.Fatalf("unexpected partition %v and %v", even, odd)
+	}
+
+	groups := set.GroupBy(s, func(elem int) int {
+		return elem % 3
+	})
+	if len(groups) != 3 {
+		t.Fatalf("expected 3 groups, got %d", len(groups))
+	}
+	expected := map[int]string{0: "{3, 6}", 1: "{1, 4, 7}", 2: "{2, 5}"}
+	for key, group := range groups {
+		if group.String() != expected[key] {
+			t.Fatalf("expected group %s for key %d, got %v", expected[key], key, group)
+		}
+	}
+}
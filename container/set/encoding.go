@@ -0,0 +1,156 @@
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/slices"
+)
+
+// textSeparator separates the elements in the text representation of a set.
+const textSeparator = ","
+
+// MarshalJSON encodes the set as a JSON array. The elements are sorted when their type is ordered,
+// otherwise they are sorted by their JSON encoding.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	elems, sorted := s.sortedSlice()
+	if sorted {
+		return json.Marshal(elems)
+	}
+
+	encoded := make([]json.RawMessage, len(elems))
+	for i, elem := range elems {
+		data, err := json.Marshal(elem)
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = data
+	}
+	slices.SortFunc(encoded, func(a, b json.RawMessage) bool {
+		return bytes.Compare(a, b) < 0
+	})
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON decodes a JSON array into the set, replacing its content.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var elems []T
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	s.replaceWith(elems)
+	return nil
+}
+
+// MarshalText encodes a set of strings as a comma separated list of sorted elements. It fails if
+// the elements are not strings or if any of them is empty or contains a comma.
+func (s Set[T]) MarshalText() ([]byte, error) {
+	if kind := elemKind[T](); kind != reflect.String {
+		return nil, fmt.Errorf("can't encode a set of %v as text", kind)
+	}
+	elems, _ := s.sortedSlice()
+	strs := make([]string, len(elems))
+	for i, elem := range elems {
+		strs[i] = reflect.ValueOf(elem).String()
+		if strs[i] == "" {
+			// An empty element would be indistinguishable from an empty set.
+			return nil, fmt.Errorf("can't encode an empty element as text")
+		}
+		if strings.Contains(strs[i], textSeparator) {
+			return nil, fmt.Errorf("can't encode element %q containing %q as text", strs[i],
+				textSeparator)
+		}
+	}
+	return []byte(strings.Join(strs, textSeparator)), nil
+}
+
+// UnmarshalText decodes a comma separated list of non-empty strings into the set, replacing its
+// content.
+func (s *Set[T]) UnmarshalText(text []byte) error {
+	if kind := elemKind[T](); kind != reflect.String {
+		return fmt.Errorf("can't decode a set of %v from text", kind)
+	}
+	var elems []T
+	if len(text) > 0 {
+		strs := strings.Split(string(text), textSeparator)
+		elems = make([]T, len(strs))
+		for i, str := range strs {
+			if str == "" {
+				return fmt.Errorf("can't decode an empty element from text")
+			}
+			reflect.ValueOf(&elems[i]).Elem().SetString(str)
+		}
+	}
+	s.replaceWith(elems)
+	return nil
+}
+
+// GobEncode encodes the set using the gob encoding.
+func (s Set[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.ToSlice()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes a set encoded with GobEncode, replacing its content.
+func (s *Set[T]) GobDecode(data []byte) error {
+	var elems []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elems); err != nil {
+		return err
+	}
+	s.replaceWith(elems)
+	return nil
+}
+
+func (s *Set[T]) replaceWith(elems []T) {
+	*s = NewWithInitialSpace[T](len(elems))
+	s.AddAll(elems...)
+}
+
+// sortedSlice returns the elements of the set, sorted if their type is ordered.
+func (s Set[T]) sortedSlice() ([]T, bool) {
+	elems := s.ToSlice()
+	less := orderedLess[T]()
+	if less == nil {
+		return elems, false
+	}
+	slices.SortFunc(elems, less)
+	return elems, true
+}
+
+func elemKind[T any]() reflect.Kind {
+	var zero T
+	return reflect.ValueOf(&zero).Elem().Kind()
+}
+
+// orderedLess returns a comparator function if the elements have an ordered underlying type, as
+// described by constraints.Ordered, or nil otherwise.
+func orderedLess[T any]() generic.LessFn[T] {
+	switch elemKind[T]() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b T) bool {
+			return reflect.ValueOf(a).Int() < reflect.ValueOf(b).Int()
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Uintptr:
+		return func(a, b T) bool {
+			return reflect.ValueOf(a).Uint() < reflect.ValueOf(b).Uint()
+		}
+	case reflect.Float32, reflect.Float64:
+		return func(a, b T) bool {
+			return reflect.ValueOf(a).Float() < reflect.ValueOf(b).Float()
+		}
+	case reflect.String:
+		return func(a, b T) bool {
+			return reflect.ValueOf(a).String() < reflect.ValueOf(b).String()
+		}
+	}
+	return nil
+}
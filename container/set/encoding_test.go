@@ -0,0 +1,117 @@
+package set_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/set"
+)
+
+type point struct {
+	X, Y int
+}
+
+func TestSetString(t *testing.T) {
+	s := set.New[int]()
+	s.AddAll(10, 2, 33, 4)
+	if str := s.String(); str != "{2, 4, 10, 33}" {
+		t.Fatalf("expected sorted string representation, got %s", str)
+	}
+
+	points := set.New[point]()
+	points.AddAll(point{2, 1}, point{1, 2})
+	if str := points.String(); str != "{{1 2}, {2 1}}" {
+		t.Fatalf("expected deterministic string representation, got %s", str)
+	}
+}
+
+func TestSetJSON(t *testing.T) {
+	type payload struct {
+		IDs    set.Set[int]   `json:"ids"`
+		Points set.Set[point] `json:"points"`
+	}
+	in := payload{IDs: set.New[int](), Points: set.New[point]()}
+	in.IDs.AddAll(10, 2, 33)
+	in.Points.AddAll(point{2, 1}, point{1, 2})
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"ids":[2,10,33],"points":[{"X":1,"Y":2},{"X":2,"Y":1}]}`
+	if string(data) != expected {
+		t.Fatalf("expected %s, got %s", expected, data)
+	}
+
+	var out payload
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !out.IDs.Equal(in.IDs) || !out.Points.Equal(in.Points) {
+		t.Fatalf("expected %v, got %v", in, out)
+	}
+
+	if err := json.Unmarshal([]byte(`{"ids":{"1":{}}}`), &out); err == nil {
+		t.Fatal("expected an error when decoding an object")
+	}
+}
+
+func TestSetText(t *testing.T) {
+	s := set.New[string]()
+	s.AddAll("beta", "alpha", "gamma")
+
+	text, err := s.MarshalText()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(text) != "alpha,beta,gamma" {
+		t.Fatalf("expected sorted text, got %s", text)
+	}
+
+	decoded := set.New[string]()
+	decoded.Add("delta")
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !decoded.Equal(s) {
+		t.Fatalf("expected %v, got %v", s, decoded)
+	}
+	if err := decoded.UnmarshalText(nil); err != nil || !decoded.IsEmpty() {
+		t.Fatalf("expected empty text to decode into an empty set, got %v", decoded)
+	}
+
+	s.Add("a,b")
+	if _, err := s.MarshalText(); err == nil {
+		t.Fatal("expected an error for an element containing the separator")
+	}
+	empty := set.New[string]()
+	empty.Add("")
+	if _, err := empty.MarshalText(); err == nil {
+		t.Fatal("expected an error for an empty element")
+	}
+	if err := decoded.UnmarshalText([]byte("alpha,,beta")); err == nil {
+		t.Fatal("expected an error for an empty element")
+	}
+	if _, err := set.New[int]().MarshalText(); err == nil {
+		t.Fatal("expected an error for a set of integers")
+	}
+}
+
+func TestSetGob(t *testing.T) {
+	s := set.New[point]()
+	s.AddAll(point{1, 2}, point{3, 4})
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded set.Set[point]
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !decoded.Equal(s) {
+		t.Fatalf("expected %v, got %v", s, decoded)
+	}
+}
@@ -0,0 +1,226 @@
+package set
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/playgroundgo/genlib/container/redblack"
+	"github.com/playgroundgo/genlib/generic"
+)
+
+// OrderedSet implements a set container which keeps its elements sorted, using a red-black tree.
+type OrderedSet[T any] struct {
+	tree *redblack.Tree[T, struct{}]
+	less generic.LessFn[T]
+}
+
+// NewOrdered creates a new ordered set which sorts the elements using the provided comparator
+// function.
+func NewOrdered[T any](less generic.LessFn[T]) *OrderedSet[T] {
+	return &OrderedSet[T]{
+		tree: redblack.New[T, struct{}](less),
+		less: less,
+	}
+}
+
+// Size returns the number of elements in the set.
+func (s *OrderedSet[T]) Size() int {
+	return int(s.tree.Size())
+}
+
+// IsEmpty returns 'true' if the set is empty.
+func (s *OrderedSet[T]) IsEmpty() bool {
+	return s.tree.IsEmpty()
+}
+
+// Equal checks if this set is equal with another one.
+func (s *OrderedSet[T]) Equal(other *OrderedSet[T]) bool {
+	if s.Size() != other.Size() {
+		return false
+	}
+	equal := true
+	s.ForEach(func(elem T) bool {
+		equal = other.Contains(elem)
+		return equal
+	})
+	return equal
+}
+
+// String returns a string representation of the set, with the elements in ascending order.
+func (s *OrderedSet[T]) String() string {
+	var sb strings.Builder
+	i := 0
+	size := s.Size()
+	sb.WriteString("{")
+
+	s.ForEach(func(elem T) bool {
+		sb.WriteString(fmt.Sprintf("%v", elem))
+		i++
+		if i < size {
+			sb.WriteString(", ")
+		}
+		return true
+	})
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// Clear clears the content of the set.
+func (s *OrderedSet[T]) Clear() {
+	s.tree = redblack.New[T, struct{}](s.less)
+}
+
+// Clone returns a copy of the given set.
+func (s *OrderedSet[T]) Clone() *OrderedSet[T] {
+	return s.fromSorted(s.ToSlice())
+}
+
+// Contains verifies if an element belongs to the set.
+func (s *OrderedSet[T]) Contains(elem T) bool {
+	_, found := s.tree.Find(elem)
+	return found
+}
+
+// ContainsAll verifies if all the specified elements are found in the set.
+func (s *OrderedSet[T]) ContainsAll(elems ...T) bool {
+	for _, elem := range elems {
+		if !s.Contains(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny verifies if any element is found in the set.
+func (s *OrderedSet[T]) ContainsAny(elems ...T) bool {
+	for _, elem := range elems {
+		if s.Contains(elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// ForEach calls the 'f' function for each element in the set, in ascending order, while the
+// function returns true.
+func (s *OrderedSet[T]) ForEach(f func(elem T) bool) {
+	s.tree.Ascend(func(elem T, _ struct{}) bool {
+		return f(elem)
+	})
+}
+
+// ForEachReverse calls the 'f' function for each element in the set, in descending order, while
+// the function returns true.
+func (s *OrderedSet[T]) ForEachReverse(f func(elem T) bool) {
+	s.tree.Descend(func(elem T, _ struct{}) bool {
+		return f(elem)
+	})
+}
+
+// ForEachInRange calls the 'f' function, in ascending order, for each element in the range
+// [lo, hi) while the function returns true.
+func (s *OrderedSet[T]) ForEachInRange(lo, hi T, f func(elem T) bool) {
+	s.tree.AscendRange(lo, hi, func(elem T, _ struct{}) bool {
+		return f(elem)
+	})
+}
+
+// Range returns a new set containing the elements of this set found in the range [lo, hi).
+func (s *OrderedSet[T]) Range(lo, hi T) *OrderedSet[T] {
+	elems := make([]T, 0, s.tree.CountRange(lo, hi))
+	s.ForEachInRange(lo, hi, func(elem T) bool {
+		elems = append(elems, elem)
+		return true
+	})
+	return s.fromSorted(elems)
+}
+
+// First returns the smallest element in the set.
+func (s *OrderedSet[T]) First() (T, bool) {
+	elem, _, ok := s.tree.Min()
+	return elem, ok
+}
+
+// Last returns the largest element in the set.
+func (s *OrderedSet[T]) Last() (T, bool) {
+	elem, _, ok := s.tree.Max()
+	return elem, ok
+}
+
+// Add adds an element to the set.
+func (s *OrderedSet[T]) Add(elem T) {
+	s.tree.Insert(elem, struct{}{})
+}
+
+// AddAll adds all the specified elements in the set.
+func (s *OrderedSet[T]) AddAll(elems ...T) {
+	for _, elem := range elems {
+		s.Add(elem)
+	}
+}
+
+// Remove removes an element from the set.
+func (s *OrderedSet[T]) Remove(elem T) {
+	s.tree.Delete(elem)
+}
+
+// RemoveAll removes all the specified elements from the set.
+func (s *OrderedSet[T]) RemoveAll(elems ...T) {
+	for _, elem := range elems {
+		s.Remove(elem)
+	}
+}
+
+// Intersect returns the intersection between this set and another one.
+func (s *OrderedSet[T]) Intersect(other *OrderedSet[T]) *OrderedSet[T] {
+	smaller, larger := s, other
+	if smaller.Size() > larger.Size() {
+		smaller, larger = larger, smaller
+	}
+	elems := make([]T, 0, smaller.Size())
+	smaller.ForEach(func(elem T) bool {
+		if larger.Contains(elem) {
+			elems = append(elems, elem)
+		}
+		return true
+	})
+	return s.fromSorted(elems)
+}
+
+// Union returns the union between this set and another one.
+func (s *OrderedSet[T]) Union(other *OrderedSet[T]) *OrderedSet[T] {
+	first, second := s.ToSlice(), other.ToSlice()
+	elems := make([]T, 0, len(first)+len(second))
+	for len(first) > 0 && len(second) > 0 {
+		switch generic.CompareBy(first[0], second[0], s.less) {
+		case -1:
+			elems = append(elems, first[0])
+			first = first[1:]
+		case 1:
+			elems = append(elems, second[0])
+			second = second[1:]
+		default:
+			elems = append(elems, first[0])
+			first, second = first[1:], second[1:]
+		}
+	}
+	elems = append(elems, first...)
+	elems = append(elems, second...)
+	return s.fromSorted(elems)
+}
+
+// ToSlice returns a slice containing all the elements from the set in ascending order.
+func (s *OrderedSet[T]) ToSlice() []T {
+	elems := make([]T, 0, s.Size())
+	s.ForEach(func(elem T) bool {
+		elems = append(elems, elem)
+		return true
+	})
+	return elems
+}
+
+func (s *OrderedSet[T]) fromSorted(elems []T) *OrderedSet[T] {
+	// The elements are always sorted and unique, so building the tree can't fail.
+	tree, _ := redblack.FromSorted(s.less, elems, make([]struct{}, len(elems)))
+	return &OrderedSet[T]{tree: tree, less: s.less}
+}
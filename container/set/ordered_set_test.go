@@ -0,0 +1,97 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/set"
+	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/slices"
+)
+
+func TestBasicOrderedSetOperations(t *testing.T) {
+	s := set.NewOrdered(generic.Less[int])
+	s.AddAll(5, 3, 1, 4)
+	s.Add(3)
+
+	if s.Size() != 4 {
+		t.Fatalf("expected to have 4 elements in the set, got %d", s.Size())
+	}
+	if !s.ContainsAll(1, 3, 4, 5) || s.Contains(2) || !s.ContainsAny(2, 5) {
+		t.Fatalf("unexpected set content %v", s)
+	}
+	if str := s.String(); str != "{1, 3, 4, 5}" {
+		t.Fatalf("expected sorted string representation, got %s", str)
+	}
+
+	first, _ := s.First()
+	last, _ := s.Last()
+	if first != 1 || last != 5 {
+		t.Fatalf("expected first and last elements to be 1 and 5, got %d and %d", first, last)
+	}
+
+	clone := s.Clone()
+	s.RemoveAll(1, 5)
+	s.Remove(7)
+	if !slices.Equal(s.ToSlice(), []int{3, 4}) {
+		t.Fatalf("expected set {3, 4}, got %v", s)
+	}
+	if clone.Size() != 4 || s.Equal(clone) {
+		t.Fatal("didn't expected the clone to be modified")
+	}
+
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Fatal("expected the set to be empty")
+	}
+	if _, ok := s.First(); ok {
+		t.Fatal("didn't expected an empty set to have a first element")
+	}
+	s.AddAll(clone.ToSlice()...)
+	if !s.Equal(clone) {
+		t.Fatal("expected the set to be equal with the clone")
+	}
+}
+
+func TestOrderedSetIteration(t *testing.T) {
+	s := set.NewOrdered(generic.Less[string])
+	s.AddAll("delta", "alpha", "charlie", "bravo", "echo")
+
+	collected := make([]string, 0)
+	s.ForEachReverse(func(elem string) bool {
+		collected = append(collected, elem)
+		return elem != "charlie"
+	})
+	expected := []string{"echo", "delta", "charlie"}
+	if !slices.Equal(collected, expected) {
+		t.Fatalf("expected %v, got %v", expected, collected)
+	}
+
+	view := s.Range("b", "d")
+	expected = []string{"bravo", "charlie"}
+	if !slices.Equal(view.ToSlice(), expected) {
+		t.Fatalf("expected range %v, got %v", expected, view)
+	}
+	view.Add("coffee")
+	if s.Contains("coffee") {
+		t.Fatal("didn't expected the range to share elements with the set")
+	}
+}
+
+func TestOrderedSetAlgebra(t *testing.T) {
+	s1 := set.NewOrdered(generic.Less[int])
+	s2 := set.NewOrdered(generic.Less[int])
+	s1.AddAll(1, 2, 3, 4)
+	s2.AddAll(3, 4, 5, 6, 7)
+
+	union := s1.Union(s2)
+	if expected := []int{1, 2, 3, 4, 5, 6, 7}; !slices.Equal(union.ToSlice(), expected) {
+		t.Fatalf("expected union %v, got %v", expected, union)
+	}
+	intersection := s1.Intersect(s2)
+	if expected := []int{3, 4}; !slices.Equal(intersection.ToSlice(), expected) {
+		t.Fatalf("expected intersection %v, got %v", expected, intersection)
+	}
+	if !s2.Intersect(s1).Equal(intersection) {
+		t.Fatal("expected the intersection to be commutative")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/slices"
 )
 
 // Set implements a set container using the standard library map container.
@@ -43,22 +44,18 @@ func (s Set[T]) Equal(other Set[T]) bool {
 	return true
 }
 
-// String returns a string representation of the set.
+// String returns a string representation of the set. The elements are sorted when their type is
+// ordered, otherwise they are sorted by their string representation.
 func (s Set[T]) String() string {
-	var sb strings.Builder
-	i := 0
-	size := len(s)
-	sb.WriteString("{")
-
-	for elem := range s {
-		sb.WriteString(fmt.Sprintf("%v", elem))
-		i++
-		if i < size {
-			sb.WriteString(", ")
-		}
+	elems, sorted := s.sortedSlice()
+	strs := make([]string, len(elems))
+	for i, elem := range elems {
+		strs[i] = fmt.Sprintf("%v", elem)
+	}
+	if !sorted {
+		slices.Sort(strs)
 	}
-	sb.WriteString("}")
-	return sb.String()
+	return "{" + strings.Join(strs, ", ") + "}"
 }
 
 // Clear clears the content of the set.
@@ -156,6 +153,76 @@ func (s Set[T]) Union(other Set[T]) Set[T] {
 	return result
 }
 
+// UnionInPlace adds all the elements of another set to this set.
+func (s Set[T]) UnionInPlace(other Set[T]) {
+	for elem := range other {
+		s.Add(elem)
+	}
+}
+
+// RetainAll removes from this set all the elements which are not found in another set.
+func (s Set[T]) RetainAll(other Set[T]) {
+	for elem := range s {
+		if !other.Contains(elem) {
+			s.Remove(elem)
+		}
+	}
+}
+
+// Difference returns the elements of this set which are not found in another set.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := New[T]()
+	for elem := range s {
+		if !other.Contains(elem) {
+			result.Add(elem)
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns the elements which are found in exactly one of the two sets.
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	result := s.Difference(other)
+	for elem := range other {
+		if !s.Contains(elem) {
+			result.Add(elem)
+		}
+	}
+	return result
+}
+
+// IsSubsetOf verifies if all the elements of this set are found in another set.
+func (s Set[T]) IsSubsetOf(other Set[T]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+	for elem := range s {
+		if !other.Contains(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf verifies if all the elements of another set are found in this set.
+func (s Set[T]) IsSupersetOf(other Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// IsDisjoint verifies if this set and another one have no elements in common.
+func (s Set[T]) IsDisjoint(other Set[T]) bool {
+	smaller, larger := s, other
+	if smaller.Size() > larger.Size() {
+		smaller, larger = larger, smaller
+	}
+	for elem := range smaller {
+		if larger.Contains(elem) {
+			return false
+		}
+	}
+	return true
+}
+
 // ToSlice returns a slice containing all the elements from the set.
 func (s Set[T]) ToSlice() []T {
 	elems := make([]T, 0, len(s))
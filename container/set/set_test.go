@@ -150,3 +150,72 @@ func TestIntersect(t *testing.T) {
 		t.Fatalf("expected 2 and 3 to be present in the interesection set %v", s)
 	}
 }
+
+func TestDifference(t *testing.T) {
+	s1 := set.New[int]()
+	s2 := set.New[int]()
+	s1.AddAll(1, 2, 3, 4)
+	s2.AddAll(3, 4, 5)
+
+	diff := s1.Difference(s2)
+	expected := set.New[int]()
+	expected.AddAll(1, 2)
+	if !diff.Equal(expected) {
+		t.Fatalf("expected difference %v, got %v", expected, diff)
+	}
+
+	symDiff := s1.SymmetricDifference(s2)
+	expected.Add(5)
+	if !symDiff.Equal(expected) {
+		t.Fatalf("expected symmetric difference %v, got %v", expected, symDiff)
+	}
+	if !s2.SymmetricDifference(s1).Equal(symDiff) {
+		t.Fatal("expected the symmetric difference to be commutative")
+	}
+	if s1.Size() != 4 || s2.Size() != 3 {
+		t.Fatal("didn't expected the original sets to be modified")
+	}
+}
+
+func TestSubsetRelations(t *testing.T) {
+	s1 := set.New[int]()
+	s2 := set.New[int]()
+	s1.AddAll(1, 2)
+	s2.AddAll(1, 2, 3)
+
+	if !s1.IsSubsetOf(s2) || s2.IsSubsetOf(s1) {
+		t.Fatalf("expected only %v to be a subset of %v", s1, s2)
+	}
+	if !s2.IsSupersetOf(s1) || s1.IsSupersetOf(s2) {
+		t.Fatalf("expected only %v to be a superset of %v", s2, s1)
+	}
+	if !s1.IsSubsetOf(s1) || !set.New[int]().IsSubsetOf(s1) {
+		t.Fatal("expected a set and the empty set to be subsets of the set")
+	}
+	if s1.IsDisjoint(s2) {
+		t.Fatalf("didn't expected %v and %v to be disjoint", s1, s2)
+	}
+	s2.RemoveAll(1, 2)
+	if !s1.IsDisjoint(s2) || !s2.IsDisjoint(s1) {
+		t.Fatalf("expected %v and %v to be disjoint", s1, s2)
+	}
+}
+
+func TestInPlaceOperations(t *testing.T) {
+	s1 := set.New[int]()
+	s2 := set.New[int]()
+	s1.AddAll(1, 2, 3)
+	s2.AddAll(3, 4)
+
+	s1.UnionInPlace(s2)
+	expected := set.New[int]()
+	expected.AddAll(1, 2, 3, 4)
+	if !s1.Equal(expected) {
+		t.Fatalf("expected union %v, got %v", expected, s1)
+	}
+
+	s1.RetainAll(s2)
+	if !s1.Equal(s2) {
+		t.Fatalf("expected retained elements %v, got %v", s2, s1)
+	}
+}
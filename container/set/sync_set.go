@@ -0,0 +1,194 @@
+package set
+
+import (
+	"sync"
+)
+
+// SyncSet implements a set container which is safe for concurrent use by multiple goroutines.
+type SyncSet[T comparable] struct {
+	mu  sync.RWMutex
+	set Set[T]
+}
+
+// NewSync creates a new concurrency-safe set.
+func NewSync[T comparable]() *SyncSet[T] {
+	return &SyncSet[T]{
+		set: New[T](),
+	}
+}
+
+// NewSyncFrom creates a new concurrency-safe set holding a copy of the given set.
+func NewSyncFrom[T comparable](s Set[T]) *SyncSet[T] {
+	return &SyncSet[T]{
+		set: s.Clone(),
+	}
+}
+
+// Size returns the number of elements in the set.
+func (s *SyncSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Size()
+}
+
+// IsEmpty returns 'true' if the set is empty.
+func (s *SyncSet[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsEmpty()
+}
+
+// Equal checks if this set is equal with another one.
+func (s *SyncSet[T]) Equal(other Set[T]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Equal(other)
+}
+
+// String returns a string representation of the set.
+func (s *SyncSet[T]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.String()
+}
+
+// Clear clears the content of the set.
+func (s *SyncSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Clear()
+}
+
+// Clone returns an atomic snapshot of the set content.
+func (s *SyncSet[T]) Clone() Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Clone()
+}
+
+// Contains verifies if an element belongs to the set.
+func (s *SyncSet[T]) Contains(elem T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Contains(elem)
+}
+
+// ContainsAll verifies if all the specified elements are found in the set.
+func (s *SyncSet[T]) ContainsAll(elems ...T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.ContainsAll(elems...)
+}
+
+// ContainsAny verifies if any element is found in the set.
+func (s *SyncSet[T]) ContainsAny(elems ...T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.ContainsAny(elems...)
+}
+
+// ForEach calls the 'f' function for each element in the set while the function returns true.
+// The set is locked for reading during the iteration, so the function must not modify it.
+func (s *SyncSet[T]) ForEach(f func(elem T) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.set.ForEach(f)
+}
+
+// Add adds an element to the set.
+func (s *SyncSet[T]) Add(elem T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Add(elem)
+}
+
+// AddAll adds all the specified elements in the set.
+func (s *SyncSet[T]) AddAll(elems ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.AddAll(elems...)
+}
+
+// Remove removes an element from the set.
+func (s *SyncSet[T]) Remove(elem T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Remove(elem)
+}
+
+// RemoveAll removes all the specified elements from the set.
+func (s *SyncSet[T]) RemoveAll(elems ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.RemoveAll(elems...)
+}
+
+// Intersect returns the intersection between this set and another one.
+func (s *SyncSet[T]) Intersect(other Set[T]) Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Intersect(other)
+}
+
+// Union returns the union between this set and another one.
+func (s *SyncSet[T]) Union(other Set[T]) Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Union(other)
+}
+
+// UnionInPlace adds all the elements of another set to this set.
+func (s *SyncSet[T]) UnionInPlace(other Set[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.UnionInPlace(other)
+}
+
+// RetainAll removes from this set all the elements which are not found in another set.
+func (s *SyncSet[T]) RetainAll(other Set[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.RetainAll(other)
+}
+
+// Difference returns the elements of this set which are not found in another set.
+func (s *SyncSet[T]) Difference(other Set[T]) Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Difference(other)
+}
+
+// SymmetricDifference returns the elements which are found in exactly one of the two sets.
+func (s *SyncSet[T]) SymmetricDifference(other Set[T]) Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.SymmetricDifference(other)
+}
+
+// IsSubsetOf verifies if all the elements of this set are found in another set.
+func (s *SyncSet[T]) IsSubsetOf(other Set[T]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsSubsetOf(other)
+}
+
+// IsSupersetOf verifies if all the elements of another set are found in this set.
+func (s *SyncSet[T]) IsSupersetOf(other Set[T]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsSupersetOf(other)
+}
+
+// IsDisjoint verifies if this set and another one have no elements in common.
+func (s *SyncSet[T]) IsDisjoint(other Set[T]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsDisjoint(other)
+}
+
+// ToSlice returns a slice containing all the elements from the set.
+func (s *SyncSet[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.ToSlice()
+}
@@ -0,0 +1,84 @@
+package set_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/set"
+)
+
+func TestSyncSetConcurrentAccess(t *testing.T) {
+	s := set.NewSync[int]()
+	const writers, elemsPerWriter = 4, 1000
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < elemsPerWriter; i++ {
+				s.Add(w*elemsPerWriter + i)
+				if i%2 == 0 {
+					s.Remove(w*elemsPerWriter + i)
+				}
+			}
+		}(w)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < elemsPerWriter; i++ {
+				s.Contains(i)
+				if i%100 == 0 {
+					snapshot := s.Clone()
+					snapshot.Add(-1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.Size() != writers*elemsPerWriter/2 {
+		t.Fatalf("expected %d elements in the set, got %d", writers*elemsPerWriter/2, s.Size())
+	}
+	if s.Contains(-1) {
+		t.Fatal("didn't expected modifications of a snapshot to affect the set")
+	}
+	s.ForEach(func(elem int) bool {
+		if elem%2 == 0 {
+			t.Fatalf("didn't expected even element %d in the set", elem)
+		}
+		return true
+	})
+}
+
+func TestSyncSetOperations(t *testing.T) {
+	base := set.New[int]()
+	base.AddAll(1, 2, 3)
+	s := set.NewSyncFrom(base)
+	base.Add(4)
+
+	if s.Size() != 3 || s.Contains(4) {
+		t.Fatalf("expected the sync set to hold a copy of the original set, got %v", s)
+	}
+
+	other := set.New[int]()
+	other.AddAll(3, 4)
+	if !s.Union(other).Equal(base) {
+		t.Fatalf("expected union %v, got %v", base, s.Union(other))
+	}
+	if s.Intersect(other).Size() != 1 || !s.Difference(other).ContainsAll(1, 2) {
+		t.Fatal("unexpected intersection or difference")
+	}
+
+	s.UnionInPlace(other)
+	if !s.Equal(base) || !s.IsSupersetOf(other) || s.IsDisjoint(other) {
+		t.Fatalf("expected %v, got %v", base, s)
+	}
+	s.RetainAll(other)
+	if !s.Equal(other) || !s.IsSubsetOf(base) {
+		t.Fatalf("expected %v, got %v", other, s)
+	}
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Fatal("expected the set to be empty")
+	}
+}
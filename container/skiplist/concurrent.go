@@ -0,0 +1,285 @@
+package skiplist
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/playgroundgo/genlib/generic"
+)
+
+type concurrentNode[K, V any] struct {
+	key   K
+	value atomic.Pointer[V]
+	next  []atomic.Pointer[concurrentNode[K, V]]
+	// mu guards the changes of the links to the next nodes.
+	mu sync.Mutex
+	// marked is set when the node is being deleted.
+	marked atomic.Bool
+	// linked is set when the node was linked on all its levels.
+	linked atomic.Bool
+}
+
+func (n *concurrentNode[K, V]) isLive() bool {
+	return n.linked.Load() && !n.marked.Load()
+}
+
+// Concurrent implements an ordered map using a skip list which is safe for concurrent use by
+// multiple goroutines. The readers never block, while the writers only lock the nodes next to the
+// modified key, so writers working on distant keys don't contend with each other. The iterations
+// are weakly consistent: they reflect the changes made concurrently to the keys not yet visited.
+type Concurrent[K, V any] struct {
+	head        concurrentNode[K, V]
+	less        generic.LessFn[K]
+	probability float64
+	size        atomic.Int64
+}
+
+// NewConcurrent creates a new concurrency-safe skip list which sorts the keys using the provided
+// comparator function.
+func NewConcurrent[K, V any](less generic.LessFn[K]) *Concurrent[K, V] {
+	return NewConcurrentWithProbability[K, V](less, DefaultProbability)
+}
+
+// NewConcurrentWithProbability creates a new concurrency-safe skip list which promotes each
+// element to the next level with the given probability. It panics if the probability is not in
+// the range (0, 1).
+func NewConcurrentWithProbability[K, V any](less generic.LessFn[K],
+	probability float64) *Concurrent[K, V] {
+	checkProbability(probability)
+	s := &Concurrent[K, V]{
+		less:        less,
+		probability: probability,
+	}
+	s.head.next = make([]atomic.Pointer[concurrentNode[K, V]], maxLevel)
+	s.head.linked.Store(true)
+	return s
+}
+
+// Len returns the number of elements in the skip list.
+func (s *Concurrent[K, V]) Len() int {
+	return int(s.size.Load())
+}
+
+// IsEmpty returns 'true' if the skip list has no elements.
+func (s *Concurrent[K, V]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Get returns the value associated with the given key.
+func (s *Concurrent[K, V]) Get(key K) (V, bool) {
+	var preds, succs [maxLevel]*concurrentNode[K, V]
+	if level := s.find(key, &preds, &succs); level >= 0 && succs[level].isLive() {
+		return *succs[level].value.Load(), true
+	}
+	var tmp V
+	return tmp, false
+}
+
+// Put associates the given value with the key, replacing the previous value if the key is
+// already present. It returns 'true' if the key was inserted.
+func (s *Concurrent[K, V]) Put(key K, value V) bool {
+	level := randomLevel(s.probability)
+	var preds, succs [maxLevel]*concurrentNode[K, V]
+	for {
+		if found := s.find(key, &preds, &succs); found >= 0 {
+			n := succs[found]
+			if n.marked.Load() {
+				// The node is being deleted, so retry once it's unlinked.
+				runtime.Gosched()
+				continue
+			}
+			for !n.linked.Load() {
+				runtime.Gosched()
+			}
+			// Delete marks and unlinks the node while holding its lock, so the value can't be
+			// stored on a node being deleted.
+			n.mu.Lock()
+			if n.marked.Load() {
+				n.mu.Unlock()
+				continue
+			}
+			n.value.Store(&value)
+			n.mu.Unlock()
+			return false
+		}
+
+		valid := true
+		for i := 0; valid && i < level; i++ {
+			if i == 0 || preds[i] != preds[i-1] {
+				preds[i].mu.Lock()
+			}
+			valid = !preds[i].marked.Load() && (succs[i] == nil || !succs[i].marked.Load()) &&
+				preds[i].next[i].Load() == succs[i]
+			if !valid {
+				unlockPreds(&preds, i+1)
+			}
+		}
+		if !valid {
+			continue
+		}
+
+		n := &concurrentNode[K, V]{
+			key:  key,
+			next: make([]atomic.Pointer[concurrentNode[K, V]], level),
+		}
+		n.value.Store(&value)
+		for i := 0; i < level; i++ {
+			n.next[i].Store(succs[i])
+			preds[i].next[i].Store(n)
+		}
+		n.linked.Store(true)
+		unlockPreds(&preds, level)
+		s.size.Add(1)
+		return true
+	}
+}
+
+// Delete removes the given key from the skip list and returns the value associated with it.
+func (s *Concurrent[K, V]) Delete(key K) (V, bool) {
+	var preds, succs [maxLevel]*concurrentNode[K, V]
+	var victim *concurrentNode[K, V]
+	for {
+		found := s.find(key, &preds, &succs)
+		if victim == nil {
+			// Only a fully linked node found on its top level can be deleted, otherwise it's either
+			// being inserted or already being deleted.
+			if found < 0 || !succs[found].isLive() || len(succs[found].next) != found+1 {
+				var tmp V
+				return tmp, false
+			}
+			victim = succs[found]
+			victim.mu.Lock()
+			if victim.marked.Load() {
+				victim.mu.Unlock()
+				var tmp V
+				return tmp, false
+			}
+			victim.marked.Store(true)
+		}
+
+		level := len(victim.next)
+		valid := true
+		for i := 0; valid && i < level; i++ {
+			if i == 0 || preds[i] != preds[i-1] {
+				preds[i].mu.Lock()
+			}
+			valid = !preds[i].marked.Load() && preds[i].next[i].Load() == victim
+			if !valid {
+				unlockPreds(&preds, i+1)
+			}
+		}
+		if !valid {
+			continue
+		}
+
+		for i := level - 1; i >= 0; i-- {
+			preds[i].next[i].Store(victim.next[i].Load())
+		}
+		value := *victim.value.Load()
+		victim.mu.Unlock()
+		unlockPreds(&preds, level)
+		s.size.Add(-1)
+		return value, true
+	}
+}
+
+// Floor returns the element having the largest key less than or equal to the given key.
+func (s *Concurrent[K, V]) Floor(key K) (K, V, bool) {
+	n := &s.head
+	for i := maxLevel - 1; i >= 0; i-- {
+		// The nodes being inserted or deleted are skipped, so 'n' is always a live node.
+		next := n.next[i].Load()
+		for next != nil && !s.less(key, next.key) {
+			if next.isLive() {
+				n = next
+			}
+			next = next.next[i].Load()
+		}
+	}
+	if n == &s.head {
+		n = nil
+	}
+	return concurrentEntry(n)
+}
+
+// Ceiling returns the element having the smallest key greater than or equal to the given key.
+func (s *Concurrent[K, V]) Ceiling(key K) (K, V, bool) {
+	return concurrentEntry(s.ceiling(key))
+}
+
+// Range calls the 'f' function, in ascending order, for each element having the key in the range
+// [lo, hi) while the function returns true.
+func (s *Concurrent[K, V]) Range(lo, hi K, f func(key K, value V) bool) {
+	for n := s.ceiling(lo); n != nil && s.less(n.key, hi); n = s.nextLive(n) {
+		if !f(n.key, *n.value.Load()) {
+			return
+		}
+	}
+}
+
+// Ascend calls the 'f' function for each element, in ascending order, while the function returns
+// true.
+func (s *Concurrent[K, V]) Ascend(f func(key K, value V) bool) {
+	for n := s.nextLive(&s.head); n != nil; n = s.nextLive(n) {
+		if !f(n.key, *n.value.Load()) {
+			return
+		}
+	}
+}
+
+// find fills 'preds' and 'succs' with the nodes found right before and after the given key on each
+// level, and returns the highest level where a node having the key was found, or -1.
+func (s *Concurrent[K, V]) find(key K, preds, succs *[maxLevel]*concurrentNode[K, V]) int {
+	found := -1
+	pred := &s.head
+	for i := maxLevel - 1; i >= 0; i-- {
+		curr := pred.next[i].Load()
+		for curr != nil && s.less(curr.key, key) {
+			pred = curr
+			curr = pred.next[i].Load()
+		}
+		if found < 0 && curr != nil && !s.less(key, curr.key) {
+			found = i
+		}
+		preds[i], succs[i] = pred, curr
+	}
+	return found
+}
+
+// ceiling returns the first live node having the key greater than or equal to the given key.
+func (s *Concurrent[K, V]) ceiling(key K) *concurrentNode[K, V] {
+	var preds, succs [maxLevel]*concurrentNode[K, V]
+	s.find(key, &preds, &succs)
+	if n := succs[0]; n == nil || n.isLive() {
+		return n
+	}
+	return s.nextLive(succs[0])
+}
+
+// nextLive returns the first live node found after the given node on the lowest level.
+func (s *Concurrent[K, V]) nextLive(n *concurrentNode[K, V]) *concurrentNode[K, V] {
+	n = n.next[0].Load()
+	for n != nil && !n.isLive() {
+		n = n.next[0].Load()
+	}
+	return n
+}
+
+// unlockPreds unlocks the distinct nodes found among the first 'level' predecessors.
+func unlockPreds[K, V any](preds *[maxLevel]*concurrentNode[K, V], level int) {
+	for i := 0; i < level; i++ {
+		if i == 0 || preds[i] != preds[i-1] {
+			preds[i].mu.Unlock()
+		}
+	}
+}
+
+func concurrentEntry[K, V any](n *concurrentNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.key, *n.value.Load(), true
+}
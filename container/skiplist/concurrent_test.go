@@ -0,0 +1,130 @@
+package skiplist_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/skiplist"
+	"github.com/playgroundgo/genlib/generic"
+)
+
+func TestConcurrent(t *testing.T) {
+	const (
+		writers = 8
+		keys    = 2000
+	)
+	s := skiplist.NewConcurrent[int, int](generic.Less[int])
+	for key := 0; key < keys; key += 2 {
+		s.Put(key, key)
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			// The writers insert the odd keys and delete the even keys, each one in its own part of
+			// the key space, while also contending on a few shared keys.
+			for key := w; key < keys; key += writers {
+				if key%2 == 1 {
+					s.Put(key, key)
+				} else {
+					s.Delete(key)
+				}
+				s.Put(-1, w)
+				s.Delete(-2)
+				s.Put(-2, w)
+			}
+		}(w)
+	}
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < keys; i++ {
+				if value, ok := s.Get(i); ok && value != i {
+					t.Errorf("expected to get %d for key %d, got %d", i, i, value)
+				}
+				s.Floor(i)
+				s.Ceiling(i)
+				prev := -3
+				s.Range(i, i+20, func(key, _ int) bool {
+					if key <= prev {
+						t.Errorf("expected ascending keys, got %d after %d", key, prev)
+					}
+					prev = key
+					return true
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.Len() != keys/2+2 {
+		t.Fatalf("expected %d keys, got %d", keys/2+2, s.Len())
+	}
+	expected := -2
+	s.Ascend(func(key, _ int) bool {
+		if key != expected {
+			t.Fatalf("expected key %d, got %d", expected, key)
+		}
+		expected++
+		if expected == 0 {
+			expected = 1
+		}
+		for expected > 0 && expected%2 == 0 {
+			expected++
+		}
+		return true
+	})
+	if expected != keys+1 {
+		t.Fatalf("expected to visit all the keys, stopped at %d", expected)
+	}
+}
+
+func TestConcurrentPutDeleteRace(t *testing.T) {
+	puts := 200000
+	if testing.Short() {
+		puts = 20000
+	}
+	s := skiplist.NewConcurrent[int, int](generic.Less[int])
+	updated := make([]bool, puts)
+	deleted := make([]bool, puts)
+	var mu sync.Mutex
+	done := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for d := 0; d < 4; d++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if value, ok := s.Delete(0); ok {
+					mu.Lock()
+					deleted[value] = true
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for i := 0; i < puts; i++ {
+		updated[i] = !s.Put(0, i)
+	}
+	close(done)
+	wg.Wait()
+
+	// A single goroutine puts the values, so once the value 'i' is put it stays in the skip list
+	// until it's either deleted or replaced by the value 'i+1', which then updates the key in place.
+	last, found := s.Get(0)
+	for i := 0; i < puts; i++ {
+		if deleted[i] || (found && last == i) || (i+1 < puts && updated[i+1]) {
+			continue
+		}
+		t.Fatalf("the value %d was lost, put returned updated=%v", i, updated[i])
+	}
+}
@@ -0,0 +1,189 @@
+package skiplist
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/playgroundgo/genlib/generic"
+)
+
+const (
+	// maxLevel is the maximum number of levels of a skip list.
+	maxLevel = 32
+	// DefaultProbability is the default probability of an element to be promoted to the next
+	// level.
+	DefaultProbability = 0.25
+)
+
+type node[K, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V]
+}
+
+// SkipList implements an ordered map using a skip list. The operations take O(log n) expected
+// time. A SkipList is not safe for concurrent use, see Concurrent for a concurrency-safe variant.
+type SkipList[K, V any] struct {
+	head        node[K, V]
+	less        generic.LessFn[K]
+	probability float64
+	level       int
+	size        int
+}
+
+// New creates a new skip list which sorts the keys using the provided comparator function.
+func New[K, V any](less generic.LessFn[K]) *SkipList[K, V] {
+	return NewWithProbability[K, V](less, DefaultProbability)
+}
+
+// NewWithProbability creates a new skip list which promotes each element to the next level with
+// the given probability. Lower probabilities use less memory, while higher probabilities make the
+// searches faster. It panics if the probability is not in the range (0, 1).
+func NewWithProbability[K, V any](less generic.LessFn[K], probability float64) *SkipList[K, V] {
+	checkProbability(probability)
+	return &SkipList[K, V]{
+		head:        node[K, V]{next: make([]*node[K, V], maxLevel)},
+		less:        less,
+		probability: probability,
+		level:       1,
+	}
+}
+
+// Len returns the number of elements in the skip list.
+func (s *SkipList[K, V]) Len() int {
+	return s.size
+}
+
+// IsEmpty returns 'true' if the skip list has no elements.
+func (s *SkipList[K, V]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Get returns the value associated with the given key.
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	if n := s.ceiling(key, nil); n != nil && !s.less(key, n.key) {
+		return n.value, true
+	}
+	var tmp V
+	return tmp, false
+}
+
+// Put associates the given value with the key, replacing the previous value if the key is
+// already present. It returns 'true' if the key was inserted.
+func (s *SkipList[K, V]) Put(key K, value V) bool {
+	var preds [maxLevel]*node[K, V]
+	if n := s.ceiling(key, &preds); n != nil && !s.less(key, n.key) {
+		n.value = value
+		return false
+	}
+
+	level := randomLevel(s.probability)
+	for ; s.level < level; s.level++ {
+		preds[s.level] = &s.head
+	}
+	n := &node[K, V]{key: key, value: value, next: make([]*node[K, V], level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = preds[i].next[i]
+		preds[i].next[i] = n
+	}
+	s.size++
+	return true
+}
+
+// Delete removes the given key from the skip list and returns the value associated with it.
+func (s *SkipList[K, V]) Delete(key K) (V, bool) {
+	var preds [maxLevel]*node[K, V]
+	n := s.ceiling(key, &preds)
+	if n == nil || s.less(key, n.key) {
+		var tmp V
+		return tmp, false
+	}
+
+	for i := range n.next {
+		preds[i].next[i] = n.next[i]
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+	return n.value, true
+}
+
+// Floor returns the element having the largest key less than or equal to the given key.
+func (s *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	n := &s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n.next[i] != nil && !s.less(key, n.next[i].key) {
+			n = n.next[i]
+		}
+	}
+	if n == &s.head {
+		n = nil
+	}
+	return entry(n)
+}
+
+// Ceiling returns the element having the smallest key greater than or equal to the given key.
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	return entry(s.ceiling(key, nil))
+}
+
+// Range calls the 'f' function, in ascending order, for each element having the key in the range
+// [lo, hi) while the function returns true.
+func (s *SkipList[K, V]) Range(lo, hi K, f func(key K, value V) bool) {
+	for n := s.ceiling(lo, nil); n != nil && s.less(n.key, hi); n = n.next[0] {
+		if !f(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// Ascend calls the 'f' function for each element, in ascending order, while the function returns
+// true.
+func (s *SkipList[K, V]) Ascend(f func(key K, value V) bool) {
+	for n := s.head.next[0]; n != nil; n = n.next[0] {
+		if !f(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// ceiling returns the first node having the key greater than or equal to the given key. If
+// 'preds' is not nil, it's filled with the last node before that key on each level.
+func (s *SkipList[K, V]) ceiling(key K, preds *[maxLevel]*node[K, V]) *node[K, V] {
+	n := &s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n.next[i] != nil && s.less(n.next[i].key, key) {
+			n = n.next[i]
+		}
+		if preds != nil {
+			preds[i] = n
+		}
+	}
+	return n.next[0]
+}
+
+func entry[K, V any](n *node[K, V]) (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
+
+// randomLevel returns a random number of levels, where each additional level has the given
+// probability.
+func randomLevel(probability float64) int {
+	level := 1
+	for level < maxLevel && rand.Float64() < probability {
+		level++
+	}
+	return level
+}
+
+func checkProbability(probability float64) {
+	if probability <= 0 || probability >= 1 {
+		panic(fmt.Sprintf("skiplist: invalid probability %v", probability))
+	}
+}
@@ -0,0 +1,161 @@
+package skiplist_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/playgroundgo/genlib/container/skiplist"
+	"github.com/playgroundgo/genlib/generic"
+	"golang.org/x/exp/slices"
+)
+
+// orderedMap is the common API of the skip lists.
+type orderedMap interface {
+	Len() int
+	Get(key int) (int, bool)
+	Put(key, value int) bool
+	Delete(key int) (int, bool)
+	Floor(key int) (int, int, bool)
+	Ceiling(key int) (int, int, bool)
+	Range(lo, hi int, f func(key, value int) bool)
+	Ascend(f func(key, value int) bool)
+}
+
+func implementations() map[string]func() orderedMap {
+	return map[string]func() orderedMap{
+		"SkipList": func() orderedMap {
+			return skiplist.New[int, int](generic.Less[int])
+		},
+		"Concurrent": func() orderedMap {
+			return skiplist.NewConcurrent[int, int](generic.Less[int])
+		},
+	}
+}
+
+func keys(m orderedMap) []int {
+	keys := make([]int, 0, m.Len())
+	m.Ascend(func(key, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func TestSkipList(t *testing.T) {
+	for name, newMap := range implementations() {
+		t.Run(name, func(t *testing.T) {
+			m := newMap()
+			if _, _, ok := m.Floor(1); ok {
+				t.Fatal("didn't expected a floor in an empty skip list")
+			}
+			for _, key := range []int{50, 10, 40, 20, 30} {
+				if !m.Put(key, key*10) {
+					t.Fatalf("expected to insert key %d", key)
+				}
+			}
+			if m.Put(20, 2000) || m.Len() != 5 {
+				t.Fatal("expected to replace the value of key 20")
+			}
+			if value, ok := m.Get(20); !ok || value != 2000 {
+				t.Fatalf("expected to get 2000 for key 20, got %d", value)
+			}
+			if expected := []int{10, 20, 30, 40, 50}; !slices.Equal(keys(m), expected) {
+				t.Fatalf("expected keys %v, got %v", expected, keys(m))
+			}
+
+			if key, _, ok := m.Floor(35); !ok || key != 30 {
+				t.Fatalf("expected floor 30, got %d", key)
+			}
+			if key, _, ok := m.Floor(40); !ok || key != 40 {
+				t.Fatalf("expected floor 40, got %d", key)
+			}
+			if _, _, ok := m.Floor(5); ok {
+				t.Fatal("didn't expected a floor below the smallest key")
+			}
+			if key, value, ok := m.Ceiling(35); !ok || key != 40 || value != 400 {
+				t.Fatalf("expected ceiling 40, got %d", key)
+			}
+			if _, _, ok := m.Ceiling(55); ok {
+				t.Fatal("didn't expected a ceiling above the largest key")
+			}
+
+			ranged := make([]int, 0)
+			m.Range(15, 50, func(key, _ int) bool {
+				ranged = append(ranged, key)
+				return key < 30
+			})
+			if expected := []int{20, 30}; !slices.Equal(ranged, expected) {
+				t.Fatalf("expected range %v, got %v", expected, ranged)
+			}
+
+			if value, ok := m.Delete(30); !ok || value != 300 {
+				t.Fatalf("expected to delete 300 for key 30, got %d", value)
+			}
+			if _, ok := m.Delete(30); ok {
+				t.Fatal("didn't expected to delete a missing key")
+			}
+			if _, ok := m.Get(30); ok || m.Len() != 4 {
+				t.Fatal("didn't expected to find a deleted key")
+			}
+		})
+	}
+}
+
+func TestSkipListRandomized(t *testing.T) {
+	for name, newMap := range implementations() {
+		t.Run(name, func(t *testing.T) {
+			rnd := rand.New(rand.NewSource(1))
+			m := newMap()
+			model := make(map[int]int)
+			for i := 0; i < 50000; i++ {
+				key := rnd.Intn(1000)
+				switch rnd.Intn(3) {
+				case 0:
+					_, found := model[key]
+					if inserted := m.Put(key, i); inserted == found {
+						t.Fatalf("expected insertion of key %d to be %v", key, !found)
+					}
+					model[key] = i
+				case 1:
+					expected, found := model[key]
+					if value, ok := m.Delete(key); ok != found || value != expected {
+						t.Fatalf("expected to delete %d for key %d, got %d", expected, key, value)
+					}
+					delete(model, key)
+				default:
+					expected, found := model[key]
+					if value, ok := m.Get(key); ok != found || value != expected {
+						t.Fatalf("expected to get %d for key %d, got %d", expected, key, value)
+					}
+				}
+			}
+
+			expected := make([]int, 0, len(model))
+			for key := range model {
+				expected = append(expected, key)
+			}
+			slices.Sort(expected)
+			if !slices.Equal(keys(m), expected) || m.Len() != len(expected) {
+				t.Fatalf("expected keys %v, got %v", expected, keys(m))
+			}
+		})
+	}
+}
+
+func TestInvalidProbability(t *testing.T) {
+	for _, probability := range []float64{0, 1, -0.5} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("expected a panic for probability %v", probability)
+				}
+			}()
+			skiplist.NewWithProbability[int, int](generic.Less[int], probability)
+		}()
+	}
+	m := skiplist.NewWithProbability[int, int](generic.Less[int], 0.5)
+	m.Put(1, 1)
+	if _, ok := m.Get(1); !ok {
+		t.Fatal("expected to get key 1")
+	}
+}
@@ -0,0 +1,194 @@
+package ttlcache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/playgroundgo/genlib/poll"
+)
+
+// Clock provides the current time to a cache, allowing the tests to control the expiration of
+// the entries.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// ErrLoadPanicked is returned to the callers waiting for a load whose loader panicked.
+var ErrLoadPanicked = errors.New("ttlcache: loader panicked")
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// load tracks a value being loaded, so that the concurrent lookups of the same key wait for it
+// instead of loading it again.
+type load[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+	// stale is set when the key is modified during the load, so the loaded value is outdated.
+	stale bool
+}
+
+// Cache implements a cache whose entries expire after a time-to-live. The expired entries are
+// removed lazily, when they are looked up, or periodically by the janitor. A Cache is safe for
+// concurrent use by multiple goroutines.
+type Cache[K comparable, V any] struct {
+	mu    sync.Mutex
+	items map[K]entry[V]
+	loads map[K]*load[V]
+	clock Clock
+}
+
+// New returns an empty cache using the system clock.
+func New[K comparable, V any]() *Cache[K, V] {
+	return NewWithClock[K, V](systemClock{})
+}
+
+// NewWithClock returns an empty cache using the given clock.
+func NewWithClock[K comparable, V any](clock Clock) *Cache[K, V] {
+	return &Cache[K, V]{
+		items: make(map[K]entry[V]),
+		loads: make(map[K]*load[V]),
+		clock: clock,
+	}
+}
+
+// Set adds a key-value pair to the cache, or replaces the value if the key is already present.
+// The entry expires after the given time-to-live, or never if the time-to-live is not positive.
+func (c *Cache[K, V]) Set(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidateLoad(key)
+	c.set(key, value, ttl)
+}
+
+// Get returns the value associated with the given key if the entry didn't expire.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.get(key)
+}
+
+// GetOrLoad returns the value associated with the given key, calling the function 'loader' to
+// load it if the key is missing or the entry expired. A successfully loaded value is added to the
+// cache with the given time-to-live, unless the key was set or deleted while loading. Concurrent
+// calls for the same key wait for a single load to complete and share its result. If the loader
+// panics nothing is cached, the panic propagates to the caller and the waiting calls get
+// ErrLoadPanicked.
+func (c *Cache[K, V]) GetOrLoad(key K, ttl time.Duration,
+	loader func(key K) (V, error)) (V, error) {
+	c.mu.Lock()
+	if value, found := c.get(key); found {
+		c.mu.Unlock()
+		return value, nil
+	}
+	if l, found := c.loads[key]; found {
+		c.mu.Unlock()
+		<-l.done
+		return l.value, l.err
+	}
+	l := &load[V]{done: make(chan struct{})}
+	c.loads[key] = l
+	c.mu.Unlock()
+
+	completed := false
+	defer func() {
+		if !completed {
+			var tmp V
+			l.value, l.err = tmp, ErrLoadPanicked
+		}
+		c.mu.Lock()
+		delete(c.loads, key)
+		if l.err == nil && !l.stale {
+			c.set(key, l.value, ttl)
+		}
+		c.mu.Unlock()
+		close(l.done)
+	}()
+	l.value, l.err = loader(key)
+	completed = true
+	return l.value, l.err
+}
+
+// Delete removes the given key from the cache.
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidateLoad(key)
+	delete(c.items, key)
+}
+
+// Len returns the number of entries in the cache, including the expired entries which were not
+// removed yet.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+// DeleteExpired removes all the expired entries from the cache and returns their number.
+func (c *Cache[K, V]) DeleteExpired() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.clock.Now()
+	deleted := 0
+	for key, e := range c.items {
+		if e.expired(now) {
+			delete(c.items, key)
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// RunJanitor removes the expired entries from the cache with the given interval until the context
+// is done, returning the context error.
+func (c *Cache[K, V]) RunJanitor(ctx context.Context, interval time.Duration) error {
+	return poll.PollWhile(ctx, interval, func() (bool, error) {
+		c.DeleteExpired()
+		return true, nil
+	})
+}
+
+// invalidateLoad prevents the value being loaded for the given key, if any, from replacing a more
+// recent change of the key.
+func (c *Cache[K, V]) invalidateLoad(key K) {
+	if l, found := c.loads[key]; found {
+		l.stale = true
+	}
+}
+
+func (c *Cache[K, V]) set(key K, value V, ttl time.Duration) {
+	e := entry[V]{value: value}
+	if ttl > 0 {
+		e.expiresAt = c.clock.Now().Add(ttl)
+	}
+	c.items[key] = e
+}
+
+func (c *Cache[K, V]) get(key K) (V, bool) {
+	e, found := c.items[key]
+	if found && e.expired(c.clock.Now()) {
+		delete(c.items, key)
+		found = false
+	}
+	if !found {
+		var tmp V
+		return tmp, false
+	}
+	return e.value, true
+}
+
+func (e entry[V]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
@@ -0,0 +1,198 @@
+package ttlcache_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/playgroundgo/genlib/container/ttlcache"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestExpiration(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	c := ttlcache.NewWithClock[string, int](clock)
+
+	c.Set("short", 1, time.Second)
+	c.Set("long", 2, time.Minute)
+	c.Set("forever", 3, 0)
+
+	if value, ok := c.Get("short"); !ok || value != 1 {
+		t.Fatalf("expected to get 1 for key short, got %d", value)
+	}
+	clock.Advance(time.Second)
+	if _, ok := c.Get("short"); ok {
+		t.Fatal("didn't expected to get an expired entry")
+	}
+	if c.Len() != 2 {
+		t.Fatalf("expected the expired entry to be removed on lookup, got %d entries", c.Len())
+	}
+
+	clock.Advance(time.Hour)
+	if n := c.DeleteExpired(); n != 1 {
+		t.Fatalf("expected 1 expired entry, got %d", n)
+	}
+	if value, ok := c.Get("forever"); !ok || value != 3 {
+		t.Fatalf("expected to get 3 for key forever, got %d", value)
+	}
+
+	c.Delete("forever")
+	if c.Len() != 0 {
+		t.Fatal("expected the cache to be empty")
+	}
+}
+
+func TestGetOrLoad(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	c := ttlcache.NewWithClock[string, int](clock)
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	loader := func(key string) (int, error) {
+		calls.Add(1)
+		<-release
+		return len(key), nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := c.GetOrLoad("four", time.Second, loader)
+			if err != nil || value != 4 {
+				t.Errorf("expected to load 4, got %d (%v)", value, err)
+			}
+		}()
+	}
+	for calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected a single load, got %d", calls.Load())
+	}
+	if value, ok := c.Get("four"); !ok || value != 4 {
+		t.Fatalf("expected the loaded value to be cached, got %d", value)
+	}
+
+	clock.Advance(time.Second)
+	if _, err := c.GetOrLoad("four", time.Second, loader); err != nil || calls.Load() != 2 {
+		t.Fatalf("expected an expired entry to be loaded again, got %d loads", calls.Load())
+	}
+
+	loadErr := errors.New("load error")
+	_, err := c.GetOrLoad("fail", time.Second, func(string) (int, error) {
+		return 0, loadErr
+	})
+	if !errors.Is(err, loadErr) {
+		t.Fatalf("expected load error, got %v", err)
+	}
+	if _, ok := c.Get("fail"); ok {
+		t.Fatal("didn't expected a failed load to be cached")
+	}
+}
+
+func TestGetOrLoadPanic(t *testing.T) {
+	c := ttlcache.New[string, int]()
+	started, release := make(chan struct{}), make(chan struct{})
+	panicked := make(chan any)
+	go func() {
+		defer func() {
+			panicked <- recover()
+		}()
+		c.GetOrLoad("k", time.Minute, func(string) (int, error) {
+			close(started)
+			<-release
+			panic("loader failure")
+		})
+	}()
+	<-started
+
+	waited := make(chan error)
+	go func() {
+		_, err := c.GetOrLoad("k", time.Minute, func(string) (int, error) {
+			t.Error("didn't expected a second load")
+			return 1, nil
+		})
+		waited <- err
+	}()
+	// Give the second call the time to start waiting for the first load.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	if recovered := <-panicked; recovered != "loader failure" {
+		t.Fatalf("expected the loader panic to propagate, got %v", recovered)
+	}
+	if err := <-waited; !errors.Is(err, ttlcache.ErrLoadPanicked) {
+		t.Fatalf("expected load panicked error, got %v", err)
+	}
+	if _, ok := c.Get("k"); ok {
+		t.Fatal("didn't expected the panicked load to be cached")
+	}
+}
+
+func TestGetOrLoadConcurrentChange(t *testing.T) {
+	c := ttlcache.New[string, int]()
+	value, err := c.GetOrLoad("set", time.Minute, func(string) (int, error) {
+		c.Set("set", 2, time.Minute)
+		return 1, nil
+	})
+	if err != nil || value != 1 {
+		t.Fatalf("expected to load 1, got %d (%v)", value, err)
+	}
+	if value, ok := c.Get("set"); !ok || value != 2 {
+		t.Fatalf("expected the value set during the load to be kept, got %d", value)
+	}
+
+	c.GetOrLoad("deleted", time.Minute, func(string) (int, error) {
+		c.Delete("deleted")
+		return 1, nil
+	})
+	if _, ok := c.Get("deleted"); ok {
+		t.Fatal("expected the deletion during the load to be kept")
+	}
+}
+
+func TestJanitor(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	c := ttlcache.NewWithClock[int, int](clock)
+	for i := 0; i < 10; i++ {
+		c.Set(i, i, time.Duration(i+1)*time.Second)
+	}
+	clock.Advance(5 * time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- c.RunJanitor(ctx, time.Millisecond)
+	}()
+	for c.Len() != 5 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled error, got %v", err)
+	}
+}
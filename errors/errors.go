@@ -6,3 +6,6 @@ import (
 
 // ErrEmpty signals that a collection is empty when it wasn't supposed to be.
 var ErrEmpty = errors.New("container is empty")
+
+// ErrFull signals that a collection is full when it wasn't supposed to be.
+var ErrFull = errors.New("container is full")